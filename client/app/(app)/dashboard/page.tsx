@@ -47,6 +47,10 @@ export default function DashboardPage() {
     dashboard && dashboard.total_invited > 0
       ? (dashboard.pending / dashboard.total_invited) * 502
       : 0;
+  const donutWaitlisted =
+    dashboard && dashboard.total_invited > 0
+      ? (dashboard.waitlisted / dashboard.total_invited) * 502
+      : 0;
   const donutDeclined =
     dashboard && dashboard.total_invited > 0
       ? (dashboard.declined / dashboard.total_invited) * 502
@@ -57,8 +61,10 @@ export default function DashboardPage() {
   const gConfirmed = guestStats?.confirmed ?? 0;
   const gPending = guestStats?.pending ?? 0;
   const gDeclined = guestStats?.declined ?? 0;
+  const gWaitlisted = guestStats?.waitlisted ?? 0;
   const donutGuestConfirmed = guestTotal > 0 ? (gConfirmed / guestTotal) * 502 : 0;
   const donutGuestPending = guestTotal > 0 ? (gPending / guestTotal) * 502 : 0;
+  const donutGuestWaitlisted = guestTotal > 0 ? (gWaitlisted / guestTotal) * 502 : 0;
   const donutGuestDeclined = guestTotal > 0 ? (gDeclined / guestTotal) * 502 : 502;
   const myRecentRSVPs = dashboard?.my_recent_rsvps ?? [];
 
@@ -149,7 +155,8 @@ export default function DashboardPage() {
                   <svg className="w-full h-full transform -rotate-90" viewBox="0 0 192 192">
                     <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutConfirmed} 502`} strokeDashoffset={0} className="text-primary" />
                     <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutPending} 502`} strokeDashoffset={-donutConfirmed} className="text-primary/70" />
-                    <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutDeclined} 502`} strokeDashoffset={-donutConfirmed - donutPending} className="text-muted" />
+                    <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutWaitlisted} 502`} strokeDashoffset={-donutConfirmed - donutPending} className="text-primary/40" />
+                    <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutDeclined} 502`} strokeDashoffset={-donutConfirmed - donutPending - donutWaitlisted} className="text-muted" />
                   </svg>
                   <div className="absolute inset-0 flex flex-col items-center justify-center">
                     <span className="text-3xl font-extrabold">{dashboard?.total_invited ?? 0}</span>
@@ -171,6 +178,13 @@ export default function DashboardPage() {
                     </div>
                     <span className="text-sm font-bold">{dashboard?.pending ?? 0}</span>
                   </div>
+                  <div className="flex items-center justify-between">
+                    <div className="flex items-center gap-2">
+                      <div className="size-3 rounded-full bg-primary/40" />
+                      <span className="text-sm font-medium text-slate-500">Waitlisted</span>
+                    </div>
+                    <span className="text-sm font-bold">{dashboard?.waitlisted ?? 0}</span>
+                  </div>
                   <div className="flex items-center justify-between">
                     <div className="flex items-center gap-2">
                       <div className="size-3 rounded-full bg-muted" />
@@ -192,7 +206,8 @@ export default function DashboardPage() {
                   <svg className="w-full h-full transform -rotate-90" viewBox="0 0 192 192">
                     <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutGuestConfirmed} 502`} strokeDashoffset={0} className="text-primary" />
                     <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutGuestPending} 502`} strokeDashoffset={-donutGuestConfirmed} className="text-primary/70" />
-                    <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutGuestDeclined} 502`} strokeDashoffset={-donutGuestConfirmed - donutGuestPending} className="text-muted" />
+                    <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutGuestWaitlisted} 502`} strokeDashoffset={-donutGuestConfirmed - donutGuestPending} className="text-primary/40" />
+                    <circle cx="96" cy="96" r="80" fill="none" stroke="currentColor" strokeWidth="12" strokeDasharray={`${donutGuestDeclined} 502`} strokeDashoffset={-donutGuestConfirmed - donutGuestPending - donutGuestWaitlisted} className="text-muted" />
                   </svg>
                   <div className="absolute inset-0 flex flex-col items-center justify-center">
                     <span className="text-3xl font-extrabold">{guestTotal}</span>
@@ -214,6 +229,13 @@ export default function DashboardPage() {
                     </div>
                     <span className="text-sm font-bold">{gPending}</span>
                   </div>
+                  <div className="flex items-center justify-between">
+                    <div className="flex items-center gap-2">
+                      <div className="size-3 rounded-full bg-primary/40" />
+                      <span className="text-sm font-medium text-slate-500">Waitlisted</span>
+                    </div>
+                    <span className="text-sm font-bold">{gWaitlisted}</span>
+                  </div>
                   <div className="flex items-center justify-between">
                     <div className="flex items-center gap-2">
                       <div className="size-3 rounded-full bg-muted" />
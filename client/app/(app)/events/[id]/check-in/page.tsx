@@ -0,0 +1,269 @@
+"use client";
+
+import { useParams, useRouter } from "next/navigation";
+import Link from "next/link";
+import { useState } from "react";
+import { useSelector } from "react-redux";
+import {
+  useGetEventQuery,
+  useGetCheckInsQuery,
+  useCheckInMutation,
+  useUndoCheckInMutation,
+  type CheckInParty,
+  type CheckInRequest,
+} from "@/lib/api/eventsApi";
+import type { RootState } from "@/lib/store";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Loader2, ScanLine, Undo2 } from "lucide-react";
+
+/** How often the door list and the arrival counts refresh while the page is open. */
+const POLL_INTERVAL_MS = 5000;
+
+type ScanResult = { kind: "ok" | "duplicate" | "error"; message: string };
+
+function formatTime(iso?: string) {
+  return iso ? new Date(iso).toLocaleTimeString([], { hour: "2-digit", minute: "2-digit" }) : "";
+}
+
+function errorMessage(err: unknown, fallback: string) {
+  const data = (err as { data?: { error?: string } })?.data;
+  return typeof data?.error === "string" ? data.error : fallback;
+}
+
+export default function EventCheckInPage() {
+  const params = useParams();
+  const router = useRouter();
+  const id = params.id as string;
+  const token = useSelector((state: RootState) => state.auth.token);
+
+  const { data: event } = useGetEventQuery(id, { skip: !id });
+  const { data, isLoading, error } = useGetCheckInsQuery(id, {
+    skip: !id || !token,
+    pollingInterval: POLL_INTERVAL_MS,
+  });
+  const [checkIn, { isLoading: isCheckingIn }] = useCheckInMutation();
+  const [undoCheckIn] = useUndoCheckInMutation();
+
+  const [ticketCode, setTicketCode] = useState("");
+  const [search, setSearch] = useState("");
+  const [result, setResult] = useState<ScanResult | null>(null);
+
+  /** Checks in a scanned ticket ({ token }) or a guest picked from the door list ({ invite_id }). */
+  const submitCheckIn = (body: CheckInRequest) => {
+    checkIn({ eventId: id, body })
+      .unwrap()
+      .then((party) => {
+        setResult({ kind: "ok", message: `${party.guest_name} checked in (${party.members.length} in party)` });
+        setTicketCode("");
+      })
+      .catch((err) => {
+        const body = (err as { data?: { code?: string; details?: { checked_in_at?: string; party?: CheckInParty } } })
+          ?.data;
+        if (body?.code === "already_checked_in") {
+          setResult({
+            kind: "duplicate",
+            message: `${body.details?.party?.guest_name ?? "Guest"} already checked in at ${formatTime(body.details?.checked_in_at)}`,
+          });
+        } else {
+          setResult({ kind: "error", message: errorMessage(err, "Check-in failed") });
+        }
+        setTicketCode("");
+      });
+  };
+
+  if (typeof window !== "undefined" && !token) {
+    router.replace("/auth?mode=signin");
+    return null;
+  }
+
+  if (isLoading) {
+    return (
+      <div className="container max-w-4xl mx-auto px-4 py-12 flex items-center justify-center">
+        <Loader2 className="size-8 animate-spin text-primary" />
+      </div>
+    );
+  }
+
+  if (error || !data) {
+    return (
+      <div className="container max-w-4xl mx-auto px-4 py-12">
+        <p className="text-destructive text-center">Only the organizer can check guests in.</p>
+        <Button variant="outline" asChild className="mt-6 mx-auto block">
+          <Link href={`/events/${id}`}>Back to Event</Link>
+        </Button>
+      </div>
+    );
+  }
+
+  const query = search.trim().toLowerCase();
+  const parties = query
+    ? data.parties.filter(
+        (p) =>
+          p.guest_name.toLowerCase().includes(query) ||
+          p.email.toLowerCase().includes(query) ||
+          p.members.some((m) => m.name.toLowerCase().includes(query))
+      )
+    : data.parties;
+
+  return (
+    <div className="container max-w-4xl mx-auto px-4 py-8 space-y-6">
+      <nav className="flex items-center gap-2 text-sm text-slate-500 dark:text-slate-400">
+        <Link href="/events" className="hover:text-primary">Events</Link>
+        <span>/</span>
+        <Link href={`/events/${id}`} className="hover:text-primary">{event?.name ?? "Event"}</Link>
+        <span>/</span>
+        <span className="font-semibold text-slate-900 dark:text-white">Check-in</span>
+      </nav>
+
+      <div className="grid grid-cols-2 sm:grid-cols-4 gap-3">
+        {[
+          { label: "Expected", value: data.expected },
+          { label: "Arrived", value: data.arrived },
+          { label: "Not yet arrived", value: data.pending },
+          { label: "No-shows", value: data.no_show },
+        ].map((s) => (
+          <div
+            key={s.label}
+            className="rounded-2xl border border-slate-200/60 dark:border-slate-700/60 bg-white/60 dark:bg-slate-800/40 p-4"
+          >
+            <p className="text-2xl font-semibold text-slate-900 dark:text-white tabular-nums">{s.value}</p>
+            <p className="text-xs text-slate-500 dark:text-slate-400">{s.label}</p>
+          </div>
+        ))}
+      </div>
+
+      <form
+        onSubmit={(e) => {
+          e.preventDefault();
+          if (ticketCode.trim()) submitCheckIn({ token: ticketCode.trim() });
+        }}
+        className="rounded-2xl border border-slate-200/80 dark:border-slate-700/80 bg-white dark:bg-slate-900 p-6 space-y-3"
+      >
+        <label htmlFor="ticket-id" className="block text-sm font-semibold text-slate-900 dark:text-white">
+          Scan a ticket
+        </label>
+        <div className="flex gap-2">
+          <Input
+            id="ticket-id"
+            autoFocus
+            autoComplete="off"
+            placeholder="Scan the QR code or paste the ticket code"
+            value={ticketCode}
+            onChange={(e) => setTicketCode(e.target.value)}
+            className="rounded-lg h-10 font-mono"
+          />
+          <Button
+            type="submit"
+            disabled={isCheckingIn || !ticketCode.trim()}
+            className="bg-primary text-primary-foreground hover:bg-primary/90 rounded-lg font-semibold flex items-center gap-2 h-10 px-6"
+          >
+            <ScanLine className="size-4" /> Check in
+          </Button>
+        </div>
+        {result && (
+          <p
+            className={
+              result.kind === "ok"
+                ? "text-sm text-emerald-600"
+                : result.kind === "duplicate"
+                  ? "text-sm text-amber-600"
+                  : "text-sm text-destructive"
+            }
+          >
+            {result.message}
+          </p>
+        )}
+      </form>
+
+      <div className="rounded-2xl border border-slate-200/80 dark:border-slate-700/80 bg-white dark:bg-slate-900">
+        <div className="px-6 py-4 border-b border-slate-200/80 dark:border-slate-700/80">
+          <Input
+            placeholder="Search guests"
+            value={search}
+            onChange={(e) => setSearch(e.target.value)}
+            className="rounded-lg h-10"
+          />
+        </div>
+        {parties.length === 0 ? (
+          <p className="px-6 py-10 text-center text-muted-foreground text-sm">No confirmed guests found.</p>
+        ) : (
+          <ul className="divide-y divide-slate-100/80 dark:divide-slate-700/80">
+            {parties.map((p) => {
+              const allArrived = p.members.every((m) => m.checked_in_at);
+              return (
+                <li key={p.invite_id} className="px-6 py-4 space-y-2">
+                  <div className="flex items-center justify-between gap-4">
+                    <div>
+                      <p className="font-medium text-slate-900 dark:text-slate-100">{p.guest_name}</p>
+                      <p className="text-xs text-muted-foreground">{p.email}</p>
+                    </div>
+                    {!allArrived && (
+                      <Button
+                        size="sm"
+                        variant="outline"
+                        disabled={isCheckingIn}
+                        onClick={() => submitCheckIn({ invite_id: p.invite_id })}
+                        className="rounded-lg"
+                      >
+                        Check in party
+                      </Button>
+                    )}
+                  </div>
+                  <ul className="space-y-1">
+                    {p.members.map((m) => (
+                      <li
+                        key={m.companion_id ?? "guest"}
+                        className="flex items-center justify-between gap-4 text-sm text-slate-600 dark:text-slate-400"
+                      >
+                        <span>
+                          {m.name}
+                          {m.companion_id ? "" : " (ticket holder)"}
+                        </span>
+                        {m.checked_in_at ? (
+                          <span className="flex items-center gap-3">
+                            <span className="text-emerald-600">Arrived {formatTime(m.checked_in_at)}</span>
+                            <button
+                              type="button"
+                              onClick={() =>
+                                undoCheckIn({
+                                  eventId: id,
+                                  inviteId: p.invite_id,
+                                  companionId: m.companion_id ?? undefined,
+                                })
+                              }
+                              className="text-slate-400 hover:text-red-600"
+                              title={m.companion_id ? "Undo check-in" : "Undo check-in for the whole party"}
+                            >
+                              <Undo2 className="size-4" />
+                            </button>
+                          </span>
+                        ) : m.companion_id ? (
+                          <button
+                            type="button"
+                            onClick={() =>
+                              submitCheckIn({
+                                invite_id: p.invite_id,
+                                include_guest: false,
+                                companion_ids: [m.companion_id as string],
+                              })
+                            }
+                            className="text-primary hover:underline"
+                          >
+                            Check in
+                          </button>
+                        ) : (
+                          <span>Not arrived</span>
+                        )}
+                      </li>
+                    ))}
+                  </ul>
+                </li>
+              );
+            })}
+          </ul>
+        )}
+      </div>
+    </div>
+  );
+}
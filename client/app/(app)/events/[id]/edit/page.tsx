@@ -14,6 +14,7 @@ import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
 import { BannerUpload } from "@/components/events/banner-upload";
+import { TimezoneSelect } from "@/components/events/timezone-select";
 import { ArrowLeft } from "lucide-react";
 import { DatePicker } from "@/components/ui/date-picker";
 import {
@@ -56,11 +57,28 @@ type EventForForm = {
   event_date: string;
   start_time?: string | null;
   end_time?: string | null;
+  timezone?: string | null;
   location?: string | null;
   latitude?: number | null;
   longitude?: number | null;
+  capacity?: number | null;
+  rsvp_deadline?: string;
+  seat_selection_opens_at?: string;
+  seat_selection_closes_at?: string;
+  seating_locked?: boolean;
 };
 
+// toLocalInput turns an RFC 3339 timestamp into a datetime-local input value.
+function toLocalInput(value?: string): string {
+  if (!value) return "";
+  const d = new Date(value);
+  return new Date(d.getTime() - d.getTimezoneOffset() * 60000).toISOString().slice(0, 16);
+}
+
+function fromLocalInput(value: string): string | undefined {
+  return value ? new Date(value).toISOString() : undefined;
+}
+
 function eventTypeToFormValue(eventType: string): string {
   const raw = typeof eventType === "string" ? eventType.trim() : "";
   if (!raw) return "other";
@@ -86,9 +104,15 @@ function formFromEvent(event: EventForForm) {
     event_date: event.event_date,
     start_time: event.start_time || "09:00:00",
     end_time: event.end_time || "17:00:00",
+    timezone: event.timezone || "UTC",
     location: event.location || "",
     latitude: event.latitude ?? 0,
     longitude: event.longitude ?? 0,
+    capacity: event.capacity ? String(event.capacity) : "",
+    rsvp_deadline: toLocalInput(event.rsvp_deadline),
+    seat_selection_opens_at: toLocalInput(event.seat_selection_opens_at),
+    seat_selection_closes_at: toLocalInput(event.seat_selection_closes_at),
+    seating_locked: event.seating_locked ?? false,
   };
 }
 
@@ -169,9 +193,17 @@ function EditEventFormContent({
       event_date: form.event_date,
       start_time: form.start_time,
       end_time: form.end_time,
+      timezone: form.timezone,
       location: form.location || "",
       latitude: form.latitude,
       longitude: form.longitude,
+      capacity: form.capacity ? Number(form.capacity) : undefined,
+      rsvp_deadline: fromLocalInput(form.rsvp_deadline),
+      seat_selection_opens_at: fromLocalInput(form.seat_selection_opens_at),
+      seat_selection_closes_at: fromLocalInput(form.seat_selection_closes_at),
+      // Only sent when toggled here, so saving the form keeps a lock set elsewhere in the meantime.
+      seating_locked:
+        form.seating_locked !== (event.seating_locked ?? false) ? form.seating_locked : undefined,
     });
   };
 
@@ -323,6 +355,17 @@ function EditEventFormContent({
                   />
                 </div>
               </div>
+              <div className="space-y-2">
+                <Label htmlFor="timezone">Time Zone</Label>
+                <TimezoneSelect
+                  id="timezone"
+                  value={form.timezone}
+                  onChange={(timezone) =>
+                    setForm((prev) => ({ ...prev, timezone }))
+                  }
+                  className="rounded-xl border-slate-200 dark:border-slate-600 shadow-sm"
+                />
+              </div>
               <BannerUpload
                 value={form.banner_url}
                 onChange={(url) =>
@@ -341,6 +384,58 @@ function EditEventFormContent({
                   }
                 />
               </div>
+              <div className="space-y-2">
+                <Label htmlFor="capacity">Capacity</Label>
+                <Input
+                  id="capacity"
+                  type="number"
+                  min={1}
+                  placeholder="No limit"
+                  value={form.capacity}
+                  onChange={(e) =>
+                    setForm((prev) => ({ ...prev, capacity: e.target.value }))
+                  }
+                  className="rounded-xl border-slate-200 dark:border-slate-600 shadow-sm"
+                  disabled={isSaving}
+                />
+                <p className="text-xs text-muted-foreground">
+                  Maximum attendees, guests they bring included. Later RSVPs join a waitlist.
+                </p>
+              </div>
+              <div className="grid grid-cols-1 sm:grid-cols-3 gap-4">
+                {(
+                  [
+                    ["rsvp_deadline", "RSVP deadline"],
+                    ["seat_selection_opens_at", "Seat selection opens"],
+                    ["seat_selection_closes_at", "Seat selection closes"],
+                  ] as const
+                ).map(([key, label]) => (
+                  <div key={key} className="space-y-2">
+                    <Label htmlFor={key}>{label}</Label>
+                    <Input
+                      id={key}
+                      type="datetime-local"
+                      value={form[key]}
+                      onChange={(e) =>
+                        setForm((prev) => ({ ...prev, [key]: e.target.value }))
+                      }
+                      className="rounded-xl border-slate-200 dark:border-slate-600 shadow-sm"
+                      disabled={isSaving}
+                    />
+                  </div>
+                ))}
+              </div>
+              <label className="flex items-center gap-2 text-sm">
+                <input
+                  type="checkbox"
+                  checked={form.seating_locked}
+                  onChange={(e) =>
+                    setForm((prev) => ({ ...prev, seating_locked: e.target.checked }))
+                  }
+                  disabled={isSaving}
+                />
+                Lock seating (guests can no longer change seats)
+              </label>
           </section>
 
           {error && (
@@ -10,12 +10,14 @@ import {
   useDeleteEventMutation,
   useGetEventInvitesQuery,
   useInviteToEventMutation,
+  useUpdateInviteMutation,
   useGetEventSeatingQuery,
   useCreateEventTableMutation,
   useDeleteEventTableMutation,
   useReorderEventTablesMutation,
   useUpdateEventTableMutation,
 } from "@/lib/api/eventsApi";
+import { downloadEventCalendar, downloadTicket } from "@/lib/api/ticketApi";
 import { useListCommentsQuery, useCreateCommentMutation, type EventCommentResponse } from "@/lib/api/commentsApi";
 import {
   useListThreadsQuery,
@@ -24,6 +26,7 @@ import {
   type EventChatMessageResponse,
 } from "@/lib/api/chatApi";
 import { useChatWebSocket } from "@/lib/hooks/useChatWebSocket";
+import { useSeatingWebSocket } from "@/lib/hooks/useSeatingWebSocket";
 import type { RootState } from "@/lib/store";
 import { Button } from "@/components/ui/button";
 import {
@@ -36,8 +39,9 @@ import {
   AlertDialogHeader,
   AlertDialogTitle,
 } from "@/components/ui/alert-dialog";
-import { ArrowLeft, Pencil, Trash2, MapPin, Calendar, UserPlus, Users, CheckCircle, Clock, ImageIcon, Table2, Plus, Loader2, Share2, MessageSquare, Send, Reply } from "lucide-react";
+import { ArrowLeft, Pencil, Trash2, MapPin, Calendar, UserPlus, Users, CheckCircle, Clock, ImageIcon, Table2, Plus, Loader2, Share2, MessageSquare, Send, Reply, ScanLine, Download, CalendarPlus } from "lucide-react";
 import { SeatingChartFloor } from "@/components/events/seating-chart-floor";
+import { RsvpQuestionsPanel } from "@/components/events/rsvp-questions-panel";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
 import {
@@ -91,9 +95,15 @@ export default function EventDetailPage() {
   const [inviteToEvent, { isLoading: isInviting, error: inviteError }] =
     useInviteToEventMutation();
   const [inviteEmail, setInviteEmail] = useState("");
-  const { data: seating = [] } = useGetEventSeatingQuery(id, {
+  const [updateInvite] = useUpdateInviteMutation();
+  const { data: seatingData, refetch: refetchSeating } = useGetEventSeatingQuery(id, {
     skip: !id || !token,
   });
+  useSeatingWebSocket(id && token ? id : null, token, () => refetchSeating());
+  const seating = seatingData?.tables ?? [];
+  const floorElements = seatingData?.elements ?? [];
+  const rooms = seatingData?.rooms ?? [];
+  const [seatingRoomId, setSeatingRoomId] = useState<string | null>(null);
   const [createTable, { isLoading: isCreatingTable }] = useCreateEventTableMutation();
   const [deleteTable, { isLoading: isDeletingTable }] = useDeleteEventTableMutation();
   const [reorderTables] = useReorderEventTablesMutation();
@@ -300,9 +310,7 @@ export default function EventDetailPage() {
                 </p>
               )}
               {event.visibility === "public" && (() => {
-                const eventDateStr = event.event_date ?? "";
-                const todayStr = new Date().toISOString().slice(0, 10);
-                const isEventPast = eventDateStr !== "" && eventDateStr < todayStr;
+                const isEventPast = event.ends_at != null && new Date(event.ends_at) <= new Date();
                 const rsvpPath = `/events/${id}/rsvp`;
                 const handleShare = () => {
                   if (typeof window !== "undefined" && typeof navigator !== "undefined") {
@@ -643,7 +651,7 @@ export default function EventDetailPage() {
                           newTableShape === "grid"
                             ? { shape: "grid" as const, rows: newTableRows, columns: newTableColumns }
                             : { shape: newTableShape, capacity: newTableCapacity };
-                        createTable({ eventId: id, body })
+                        createTable({ eventId: id, roomId: seatingRoomId ?? rooms[0]?.id, body })
                           .unwrap()
                           .then(() => {
                             setNewTableCapacity(6);
@@ -741,6 +749,10 @@ export default function EventDetailPage() {
                   {seating.length > 0 ? (
                     <SeatingChartFloor
                       tables={seating}
+                      elements={floorElements}
+                      rooms={rooms}
+                      roomId={seatingRoomId}
+                      onRoomChange={setSeatingRoomId}
                       showDelete
                       onDeleteTable={(tableId) => {
                         setTableToDeleteId(tableId);
@@ -751,7 +763,18 @@ export default function EventDetailPage() {
                           eventId: id,
                           tableId,
                           body: { position_x: x, position_y: y },
-                        }).catch(() => {});
+                        })
+                          .unwrap()
+                          .catch((err: { data?: { details?: { suggested_position_x?: number | null; suggested_position_y?: number | null } } }) => {
+                            // Dropped on another table or off the floor: snap to the nearest free spot instead.
+                            const details = err?.data?.details;
+                            if (details?.suggested_position_x == null || details?.suggested_position_y == null) return;
+                            updateEventTable({
+                              eventId: id,
+                              tableId,
+                              body: { position_x: details.suggested_position_x, position_y: details.suggested_position_y },
+                            }).catch(() => {});
+                          });
                       }}
                     />
                   ) : (
@@ -763,7 +786,7 @@ export default function EventDetailPage() {
                   <div className="px-6 py-8">
                     <p className="text-sm text-muted-foreground mb-4">View the seating chart when you RSVP to choose your seat.</p>
                     {seating.length > 0 ? (
-                      <SeatingChartFloor tables={seating} showDelete={false} />
+                      <SeatingChartFloor tables={seating} elements={floorElements} rooms={rooms} showDelete={false} />
                     ) : (
                       <p className="text-sm text-muted-foreground">No seating arrangement for this event yet.</p>
                     )}
@@ -801,13 +824,14 @@ export default function EventDetailPage() {
                         <th className="px-6 py-3.5 text-xs font-semibold uppercase tracking-wider text-slate-500 dark:text-slate-400">Name</th>
                         <th className="px-6 py-3.5 text-xs font-semibold uppercase tracking-wider text-slate-500 dark:text-slate-400">Email Address</th>
                         <th className="px-6 py-3.5 text-xs font-semibold uppercase tracking-wider text-slate-500 dark:text-slate-400">RSVP Status</th>
+                        <th className="px-6 py-3.5 text-xs font-semibold uppercase tracking-wider text-slate-500 dark:text-slate-400">Party</th>
                         <th className="px-6 py-3.5 text-xs font-semibold uppercase tracking-wider text-slate-500 dark:text-slate-400">Seating</th>
                         <th className="px-6 py-3.5 text-xs font-semibold uppercase tracking-wider text-slate-500 dark:text-slate-400">Invited</th>
                       </tr>
                     </thead>
                     <tbody className="divide-y divide-slate-100/80 dark:divide-slate-700/80">
                       {invites.length === 0 ? (
-                        <tr><td colSpan={5} className="px-6 py-10 text-center text-muted-foreground text-sm">No guests invited yet. Add a guest by email above.</td></tr>
+                        <tr><td colSpan={6} className="px-6 py-10 text-center text-muted-foreground text-sm">No guests invited yet. Add a guest by email above.</td></tr>
                       ) : invites.map((inv) => (
                         <tr key={inv.id} className="hover:bg-slate-50 dark:hover:bg-slate-800/50 transition-colors">
                           <td className="px-6 py-4">
@@ -822,6 +846,39 @@ export default function EventDetailPage() {
                               <span className={`size-2 rounded-full shrink-0 ${inv.status === "confirmed" ? "bg-primary" : inv.status === "declined" ? "bg-muted" : "bg-primary/70"}`} />
                               {inv.status}
                             </span>
+                            {inv.status === "confirmed" && (
+                              <button
+                                type="button"
+                                onClick={() => downloadTicket(id, "pdf", inv.id).catch(() => {})}
+                                className="ml-3 text-slate-400 hover:text-primary align-middle"
+                                title="Download this guest's ticket (PDF)"
+                              >
+                                <Download className="size-4" />
+                              </button>
+                            )}
+                          </td>
+                          <td className="px-6 py-4 text-sm text-slate-600 dark:text-slate-400">
+                            <div className="flex items-center gap-2">
+                              <input
+                                type="number"
+                                min={1 + (inv.companions?.length ?? 0)}
+                                max={20}
+                                defaultValue={inv.party_size}
+                                onBlur={(e) => {
+                                  const size = Number(e.target.value);
+                                  if (size && size !== inv.party_size) {
+                                    updateInvite({ eventId: id, inviteId: inv.id, party_size: size });
+                                  }
+                                }}
+                                className="w-16 rounded-lg border-slate-300 dark:border-slate-600 bg-white dark:bg-slate-900 text-sm"
+                                title="Party size: the guest plus the people they may bring"
+                              />
+                              {(inv.companions?.length ?? 0) > 0 && (
+                                <span title={inv.companions.map((c) => c.name).join(", ")}>
+                                  +{inv.companions.length}
+                                </span>
+                              )}
+                            </div>
                           </td>
                           <td className="px-6 py-4 text-sm text-slate-600 dark:text-slate-400">{inv.seat_id != null ? seatIdToLabel[inv.seat_id] ?? `Seat #${inv.seat_id}` : "—"}</td>
                           <td className="px-6 py-4 text-sm text-slate-600 dark:text-slate-400">{new Date(inv.created_at).toLocaleDateString()}</td>
@@ -835,6 +892,7 @@ export default function EventDetailPage() {
                     <Pagination total={invitesTotal} pageSize={guestsPageSize} page={guestsPage} onPageChange={setGuestsPage} onPageSizeChange={(v) => { setGuestsPageSize(v); setGuestsPage(0); }} />
                   </div>
                 )}
+                <RsvpQuestionsPanel eventId={id} />
               </div>
             )}
               </div>
@@ -857,6 +915,11 @@ export default function EventDetailPage() {
                 </div>
                 <span className="text-sm font-medium">
                   {formatEventTimeRange(event.start_time, event.end_time)}
+                  {event.timezone && (
+                    <span className="block text-xs font-normal text-slate-500 dark:text-slate-400">
+                      {event.timezone.replace(/_/g, " ")}
+                    </span>
+                  )}
                 </span>
               </div>
               {event.location && (
@@ -867,6 +930,15 @@ export default function EventDetailPage() {
                   <span className="text-sm font-medium">{event.location}</span>
                 </div>
               )}
+              <Button
+                variant="outline"
+                size="sm"
+                onClick={() => downloadEventCalendar(event.id).catch(() => {})}
+                className="w-full rounded-xl"
+              >
+                <CalendarPlus className="size-4 mr-2" />
+                Add to calendar
+              </Button>
               {isOwner && token && (
                 <div className="pt-4 mt-4 border-t border-slate-200/80 dark:border-slate-700/80 flex flex-col gap-2">
                   <Button variant="outline" size="sm" asChild className="w-full rounded-xl">
@@ -875,6 +947,12 @@ export default function EventDetailPage() {
                       Edit Event
                     </Link>
                   </Button>
+                  <Button variant="outline" size="sm" asChild className="w-full rounded-xl">
+                    <Link href={`/events/${event.id}/check-in`}>
+                      <ScanLine className="size-4 mr-2" />
+                      Check-in
+                    </Link>
+                  </Button>
                   <Button
                     variant="destructive"
                     size="sm"
@@ -9,6 +9,8 @@ import {
   useGetMyInvitationsQuery,
   useGetEventSeatingQuery,
   useRespondToInviteMutation,
+  useGetRsvpQuestionsQuery,
+  type RsvpAnswerRequest,
 } from "@/lib/api/eventsApi";
 import type { RootState } from "@/lib/store";
 import { Button } from "@/components/ui/button";
@@ -26,10 +28,15 @@ import {
 import { getErrorMessage } from "@/lib/api/errors";
 import { formatEventDate, formatEventTimeRange } from "@/lib/eventDateTime";
 import { SeatingChartFloor } from "@/components/events/seating-chart-floor";
+import { RsvpQuestionFields } from "@/components/events/rsvp-question-fields";
 import { ArrowLeft, ImageIcon, CalendarDays, MapPin } from "lucide-react";
+import { useSeatingWebSocket } from "@/lib/hooks/useSeatingWebSocket";
 
 type AttendanceChoice = "confirmed" | "declined";
 
+/** Answers per party member: "guest" for the invitee, otherwise the companion's key. */
+type PartyAnswers = Record<string, Record<string, RsvpAnswerRequest>>;
+
 export default function EventRsvpPage() {
   const params = useParams();
   const router = useRouter();
@@ -48,35 +55,53 @@ export default function EventRsvpPage() {
   const invite = myInvitation?.invite;
   const isInvited = !!myInvitation;
 
-  const { data: seating = [] } = useGetEventSeatingQuery(id, { skip: !id });
+  const { data: seatingData, refetch: refetchSeating } = useGetEventSeatingQuery(id, { skip: !id });
+  useSeatingWebSocket(id || null, token, () => refetchSeating());
+  const seating = seatingData?.tables ?? [];
+  const floorElements = seatingData?.elements ?? [];
 
   const [attendance, setAttendance] = useState<AttendanceChoice | null>(null);
   const [selectedSeatIds, setSelectedSeatIds] = useState<string[]>([]);
-  const [plusOne, setPlusOne] = useState(false);
+  const [companions, setCompanions] = useState<{ key: string; id?: string; name: string }[]>([]);
+  const [answers, setAnswers] = useState<PartyAnswers>({});
+  const { data: questionnaire } = useGetRsvpQuestionsQuery(id, { skip: !id });
+  const questions = questionnaire?.questions ?? [];
+  const companionQuestions = questions.filter((q) => q.per_companion);
   const [dietary, setDietary] = useState("");
   const [showSuccessDialog, setShowSuccessDialog] = useState(false);
 
-  const maxSeats = plusOne ? 2 : 1;
+  const maxCompanions = Math.max((invite?.party_size ?? 2) - 1, 0);
+  const maxSeats = 1 + companions.length;
 
   useEffect(() => {
-    if (invite?.status === "confirmed") setAttendance("confirmed");
+    if (invite?.status === "confirmed" || invite?.status === "waitlisted") setAttendance("confirmed");
     else if (invite?.status === "declined") setAttendance("declined");
     else setAttendance(null);
   }, [invite?.status]);
   useEffect(() => {
     const ids: string[] = [];
     if (invite?.seat_id != null) ids.push(invite.seat_id);
-    if (invite?.guest_seat_id != null) ids.push(invite.guest_seat_id);
+    for (const c of invite?.companions ?? []) {
+      if (c.seat_id != null) ids.push(c.seat_id);
+    }
     setSelectedSeatIds(ids);
-  }, [invite?.seat_id, invite?.guest_seat_id]);
+    setCompanions((invite?.companions ?? []).map((c) => ({ key: c.id, id: c.id, name: c.name })));
+  }, [invite]);
   useEffect(() => {
-    if (invite?.guest_seat_id != null) setPlusOne(true);
-  }, [invite?.guest_seat_id]);
+    const saved: PartyAnswers = {};
+    for (const a of questionnaire?.answers ?? []) {
+      const member = a.companion_id ?? "guest";
+      saved[member] = { ...saved[member], [a.question_id]: { question_id: a.question_id, text: a.text, choices: a.choices } };
+    }
+    setAnswers(saved);
+  }, [questionnaire?.answers]);
+  const setAnswer = (member: string, questionId: string, answer: RsvpAnswerRequest) =>
+    setAnswers((prev) => ({ ...prev, [member]: { ...prev[member], [questionId]: answer } }));
   useEffect(() => {
-    if (!plusOne && selectedSeatIds.length > 1) {
-      setSelectedSeatIds((prev) => prev.slice(0, 1));
+    if (selectedSeatIds.length > maxSeats) {
+      setSelectedSeatIds((prev) => prev.slice(0, maxSeats));
     }
-  }, [plusOne, selectedSeatIds.length]);
+  }, [maxSeats, selectedSeatIds.length]);
 
   const [respondToInvite, { isLoading: isSubmitting, error: submitError }] =
     useRespondToInviteMutation();
@@ -100,7 +125,16 @@ export default function EventRsvpPage() {
       eventId: id,
       status: attendance,
       seat_id: attendance === "confirmed" ? selectedSeatIds[0] ?? undefined : undefined,
-      guest_seat_id: attendance === "confirmed" ? selectedSeatIds[1] ?? undefined : undefined,
+      companions: companions
+        .map((c, i) => ({
+          id: c.id,
+          name: c.name.trim(),
+          // Seats are picked in order: yours first, then one per companion; "" frees a companion's seat.
+          seat_id: attendance === "confirmed" ? selectedSeatIds[i + 1] ?? "" : undefined,
+          answers: companionQuestions.length > 0 ? Object.values(answers[c.key] ?? {}) : undefined,
+        }))
+        .filter((c) => c.name !== ""),
+      answers: questions.length > 0 ? Object.values(answers.guest ?? {}) : undefined,
     })
       .unwrap()
       .then(() => {
@@ -114,9 +148,7 @@ export default function EventRsvpPage() {
   const notFound = eventError || !event;
   const isPublic = event?.visibility === "public";
   const isForbidden = !!token && !invLoading && !eventLoading && event && !isPublic && !isInvited;
-  const isEventPast =
-    event?.event_date != null &&
-    event.event_date < new Date().toISOString().slice(0, 10);
+  const isEventPast = event?.ends_at != null && new Date(event.ends_at) <= new Date();
   const eventErrorStatus = eventError && typeof eventError === "object" && "status" in eventError
     ? (eventError as { status?: number }).status
     : undefined;
@@ -257,6 +289,25 @@ export default function EventRsvpPage() {
           </div>
         )}
 
+        {invite?.status === "waitlisted" && (
+          <div className="mb-6 p-4 rounded-xl bg-amber-500/10 border border-amber-500/30">
+            <p className="text-sm font-medium text-amber-700 dark:text-amber-400">
+              This event is full, so you&apos;re on the waitlist. We&apos;ll email you as soon as a spot opens up.
+            </p>
+          </div>
+        )}
+
+        {(event!.seating_locked || event!.rsvp_deadline) && (
+          <div className="mb-6 space-y-1 text-sm text-muted-foreground">
+            {event!.rsvp_deadline && (
+              <p>RSVP by {new Date(event!.rsvp_deadline).toLocaleString()}.</p>
+            )}
+            {event!.seating_locked && (
+              <p>The organizer is finalising the seating chart, so seats can&apos;t be changed right now.</p>
+            )}
+          </div>
+        )}
+
         {/* When & where: inline strip, no card */}
         <div className="flex flex-wrap items-center gap-x-6 gap-y-3 mb-8 pb-8 border-b border-slate-200 dark:border-slate-800">
           <div className="flex items-center gap-2 text-slate-700 dark:text-slate-300">
@@ -321,49 +372,104 @@ export default function EventRsvpPage() {
             </div>
           </section>
 
-          {/* Section: Guest — single row with switch */}
-          <section className="flex flex-col sm:flex-row sm:items-center sm:justify-between gap-3 py-4 border-y border-slate-200 dark:border-slate-800">
-            <div>
-              <h2 className="text-sm font-medium text-slate-700 dark:text-slate-300">
-                Bringing a guest?
+          {/* Section: Companions — one name per person in the party */}
+          {maxCompanions > 0 && (
+            <section className="flex flex-col gap-3 py-4 border-y border-slate-200 dark:border-slate-800">
+              <div className="flex flex-col sm:flex-row sm:items-center sm:justify-between gap-3">
+                <div>
+                  <h2 className="text-sm font-medium text-slate-700 dark:text-slate-300">
+                    Bringing anyone?
+                  </h2>
+                  <p className="text-xs text-slate-500 dark:text-slate-400 mt-0.5">
+                    Optional; your invitation covers up to {maxCompanions}{" "}
+                    {maxCompanions === 1 ? "guest" : "guests"}
+                  </p>
+                </div>
+                <button
+                  type="button"
+                  disabled={companions.length >= maxCompanions}
+                  onClick={() => setCompanions((prev) => [...prev, { key: `new-${Date.now()}`, name: "" }])}
+                  className="text-sm font-medium text-primary hover:underline disabled:opacity-50 disabled:no-underline shrink-0"
+                >
+                  Add guest
+                </button>
+              </div>
+              {companions.map((c, i) => (
+                <div key={c.key} className="space-y-3">
+                <div className="flex items-center gap-2">
+                  <input
+                    type="text"
+                    value={c.name}
+                    placeholder={`Guest ${i + 1} name`}
+                    onChange={(e) =>
+                      setCompanions((prev) =>
+                        prev.map((p, j) => (j === i ? { ...p, name: e.target.value } : p))
+                      )
+                    }
+                    className="flex-1 rounded-lg border-slate-300 dark:border-slate-600 bg-white dark:bg-slate-900 text-sm focus:ring-2 focus:ring-primary/30 focus:border-primary"
+                  />
+                  <button
+                    type="button"
+                    onClick={() => {
+                      setCompanions((prev) => prev.filter((_, j) => j !== i));
+                      setSelectedSeatIds((prev) => prev.filter((_, j) => j !== i + 1));
+                    }}
+                    className="text-sm text-slate-500 hover:text-red-600"
+                  >
+                    Remove
+                  </button>
+                </div>
+                {attendance === "confirmed" && companionQuestions.length > 0 && (
+                  <div className="pl-4 border-l-2 border-slate-200 dark:border-slate-700">
+                    <RsvpQuestionFields
+                      idPrefix={`companion-${c.key}`}
+                      questions={companionQuestions}
+                      value={answers[c.key] ?? {}}
+                      onChange={(questionId, answer) => setAnswer(c.key, questionId, answer)}
+                    />
+                  </div>
+                )}
+                </div>
+              ))}
+            </section>
+          )}
+
+          {/* Section: Questions — the organizer's RSVP questionnaire */}
+          {attendance === "confirmed" && questions.length > 0 && (
+            <section>
+              <h2 className="text-sm font-medium text-slate-700 dark:text-slate-300 mb-3">
+                A few questions from the host
               </h2>
-              <p className="text-xs text-slate-500 dark:text-slate-400 mt-0.5">
-                Optional; only if you&apos;re attending
-              </p>
-            </div>
-            <label className="inline-flex items-center gap-2 cursor-pointer shrink-0">
-              <input
-                type="checkbox"
-                checked={plusOne}
-                onChange={(e) => setPlusOne(e.target.checked)}
-                className="sr-only peer"
+              <RsvpQuestionFields
+                idPrefix="guest"
+                questions={questions}
+                value={answers.guest ?? {}}
+                onChange={(questionId, answer) => setAnswer("guest", questionId, answer)}
               />
-              <span className="relative inline-block w-11 h-6 rounded-full bg-slate-300 dark:bg-slate-600 transition-colors after:absolute after:top-0.5 after:left-0.5 after:w-5 after:h-5 after:rounded-full after:bg-white after:shadow after:transition-transform after:content-[''] peer-checked:bg-primary peer-checked:after:translate-x-5" />
-              <span className="text-sm text-slate-600 dark:text-slate-400">
-                {plusOne ? "Yes" : "No"}
-              </span>
-            </label>
-          </section>
+            </section>
+          )}
 
           {/* Section: Seating — heading + chart only */}
           {seating.length > 0 && (
             <section>
               <h2 className="text-sm font-medium text-slate-700 dark:text-slate-300 mb-1">
                 {attendance === "confirmed"
-                  ? plusOne
-                    ? "Choose seats (you + guest)"
+                  ? companions.length > 0
+                    ? "Choose seats (you first, then your guests)"
                     : "Choose your seat"
                   : "Seating"}
               </h2>
               <p className="text-xs text-slate-500 dark:text-slate-400 mb-4">
                 {attendance === "confirmed"
-                  ? plusOne
-                    ? "Select up to two seats."
+                  ? companions.length > 0
+                    ? `Select up to ${maxSeats} seats.`
                     : "Select one seat."
                   : "Accept above to pick a seat."}
               </p>
               <SeatingChartFloor
                 tables={seating}
+                elements={floorElements}
+                rooms={seatingData?.rooms}
                 selectable={attendance === "confirmed"}
                 selectedSeatIds={selectedSeatIds}
                 currentInviteId={invite?.id}
@@ -2,10 +2,10 @@
 
 import { useParams, useRouter } from "next/navigation";
 import Link from "next/link";
-import { useRef } from "react";
+import { useState } from "react";
 import dynamic from "next/dynamic";
 import { useSelector } from "react-redux";
-import { useGetTicketQuery } from "@/lib/api/ticketApi";
+import { useGetTicketQuery, downloadTicket, type TicketFormat } from "@/lib/api/ticketApi";
 import { useGetEventQuery } from "@/lib/api/eventsApi";
 import type { RootState } from "@/lib/store";
 import { Button } from "@/components/ui/button";
@@ -21,25 +21,20 @@ export default function EventTicketPage() {
   const router = useRouter();
   const id = params.id as string;
   const token = useSelector((state: RootState) => state.auth.token);
-  const ticketRef = useRef<HTMLDivElement>(null);
+  const [downloading, setDownloading] = useState<TicketFormat | null>(null);
 
   const { data: ticket, isLoading, error } = useGetTicketQuery(id, {
     skip: !id || !token,
   });
   const { data: event } = useGetEventQuery(id, { skip: !id });
 
-  const handleDownload = async () => {
-    if (!ticketRef.current) return;
-    const html2canvas = (await import("html2canvas")).default;
-    const canvas = await html2canvas(ticketRef.current, {
-      scale: 2,
-      backgroundColor: "#ffffff",
-      logging: false,
-    });
-    const link = document.createElement("a");
-    link.download = `ticket-${event?.name ?? id}.png`;
-    link.href = canvas.toDataURL("image/png");
-    link.click();
+  const handleDownload = async (format: TicketFormat) => {
+    setDownloading(format);
+    try {
+      await downloadTicket(id, format);
+    } finally {
+      setDownloading(null);
+    }
   };
 
   if (typeof window !== "undefined" && !token) {
@@ -80,7 +75,6 @@ export default function EventTicketPage() {
 
         <div className="flex flex-col items-center gap-6">
           <div
-            ref={ticketRef}
             className="w-full max-w-2xl flex flex-row overflow-hidden rounded-xl bg-white dark:bg-slate-900 border border-slate-200 dark:border-slate-700 shadow-xl"
           >
             <div className="flex-[2.5] flex flex-col p-8 border-r border-dashed border-slate-200 dark:border-slate-700">
@@ -139,8 +133,8 @@ export default function EventTicketPage() {
               </div>
               <div className="bg-white dark:bg-slate-900 p-3 rounded-lg shadow-sm mb-4">
                 <QRCodeSVG
-                  value={ticket.ticket_id}
-                  size={96}
+                  value={ticket.token}
+                  size={128}
                   level="M"
                   includeMargin={false}
                   className="rounded"
@@ -160,11 +154,16 @@ export default function EventTicketPage() {
 
           <div className="flex flex-wrap items-center justify-center gap-6">
             <Button
-              onClick={handleDownload}
+              onClick={() => handleDownload("pdf")}
+              disabled={downloading !== null}
               className="rounded-lg gap-2 bg-primary text-primary-foreground hover:bg-primary/90"
             >
-              <Download className="size-4" />
-              Download Ticket
+              {downloading === "pdf" ? <Loader2 className="size-4 animate-spin" /> : <Download className="size-4" />}
+              Download PDF
+            </Button>
+            <Button variant="outline" onClick={() => handleDownload("png")} disabled={downloading !== null} className="rounded-lg gap-2">
+              {downloading === "png" ? <Loader2 className="size-4 animate-spin" /> : <Download className="size-4" />}
+              Download Image
             </Button>
             <Button variant="outline" asChild className="rounded-lg">
               <Link href={`/events/${id}`}>Back to Event</Link>
@@ -11,6 +11,7 @@ import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
 import { BannerUpload } from "@/components/events/banner-upload";
+import { browserTimeZone, TimezoneSelect } from "@/components/events/timezone-select";
 import {
   Lightbulb,
   Lock,
@@ -70,9 +71,11 @@ export default function NewEventPage() {
     event_date: "",
     start_time: "09:00:00",
     end_time: "17:00:00",
+    timezone: browserTimeZone(),
     location: "",
     latitude: 0,
     longitude: 0,
+    capacity: "",
   });
 
   useEffect(() => {
@@ -99,9 +102,11 @@ export default function NewEventPage() {
       event_date: form.event_date,
       start_time: form.start_time,
       end_time: form.end_time,
+      timezone: form.timezone,
       location: form.location || "",
       latitude: form.latitude,
       longitude: form.longitude,
+      capacity: form.capacity ? Number(form.capacity) : undefined,
     });
   };
 
@@ -241,16 +246,29 @@ export default function NewEventPage() {
                 </div>
               </div>
 
-              <div className="space-y-2">
-                <Label htmlFor="end_time">End Time</Label>
-                <TimePicker
-                  value={form.end_time}
-                  onChange={(end_time) =>
-                    setForm((prev) => ({ ...prev, end_time }))
-                  }
-                  placeholder="End time"
-                  className="rounded-xl border-slate-200 dark:border-slate-600"
-                />
+              <div className="grid grid-cols-1 sm:grid-cols-2 gap-6">
+                <div className="space-y-2">
+                  <Label htmlFor="end_time">End Time</Label>
+                  <TimePicker
+                    value={form.end_time}
+                    onChange={(end_time) =>
+                      setForm((prev) => ({ ...prev, end_time }))
+                    }
+                    placeholder="End time"
+                    className="rounded-xl border-slate-200 dark:border-slate-600"
+                  />
+                </div>
+                <div className="space-y-2">
+                  <Label htmlFor="timezone">Time Zone</Label>
+                  <TimezoneSelect
+                    id="timezone"
+                    value={form.timezone}
+                    onChange={(timezone) =>
+                      setForm((prev) => ({ ...prev, timezone }))
+                    }
+                    className="rounded-xl border-slate-200 dark:border-slate-600 shadow-sm"
+                  />
+                </div>
               </div>
 
               <BannerUpload
@@ -294,6 +312,24 @@ export default function NewEventPage() {
                 />
               </div>
 
+              <div className="space-y-2">
+                <Label htmlFor="capacity">Capacity</Label>
+                <Input
+                  id="capacity"
+                  type="number"
+                  min={1}
+                  placeholder="No limit"
+                  value={form.capacity}
+                  onChange={(e) =>
+                    setForm((prev) => ({ ...prev, capacity: e.target.value }))
+                  }
+                  className="rounded-xl border-slate-200 dark:border-slate-600 shadow-sm"
+                />
+                <p className="text-xs text-muted-foreground">
+                  Maximum attendees, guests they bring included. Later RSVPs join a waitlist.
+                </p>
+              </div>
+
               <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
                 <div className="space-y-2">
                   <Label htmlFor="visibility">Visibility</Label>
@@ -34,11 +34,12 @@ export default function GuestDashboardPage() {
   const firstName = user?.first_name ?? user?.email?.split("@")[0] ?? "there";
   const pending = invitations.filter((i) => i.invite.status === "pending");
   const confirmed = invitations.filter((i) => i.invite.status === "confirmed");
+  const now = Date.now();
   const upcoming = [...invitations]
-    .filter((i) => i.invite.status !== "declined")
+    .filter((i) => i.invite.status !== "declined" && new Date(i.event.ends_at).getTime() > now)
     .sort(
       (a, b) =>
-        new Date(a.event.event_date).getTime() - new Date(b.event.event_date).getTime()
+        new Date(a.event.starts_at).getTime() - new Date(b.event.starts_at).getTime()
     )
     .slice(0, 3);
 
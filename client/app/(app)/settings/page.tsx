@@ -1,17 +1,22 @@
 "use client";
 
-import { useEffect } from "react";
+import { useEffect, useState } from "react";
 import Link from "next/link";
 import { useRouter } from "next/navigation";
 import { useSelector } from "react-redux";
 import type { RootState } from "@/lib/store";
+import { useGetCalendarFeedQuery, useResetCalendarFeedMutation } from "@/lib/api/authApi";
 import { Button } from "@/components/ui/button";
 import { Card, CardContent, CardHeader } from "@/components/ui/card";
-import { ArrowLeft } from "lucide-react";
+import { Input } from "@/components/ui/input";
+import { ArrowLeft, CalendarPlus, Copy, RefreshCw } from "lucide-react";
 
 export default function SettingsPage() {
   const router = useRouter();
   const token = useSelector((state: RootState) => state.auth.token);
+  const { data: feed } = useGetCalendarFeedQuery(undefined, { skip: !token });
+  const [resetFeed, { isLoading: isResetting }] = useResetCalendarFeedMutation();
+  const [copied, setCopied] = useState(false);
 
   useEffect(() => {
     if (!token) {
@@ -41,9 +46,54 @@ export default function SettingsPage() {
             Manage your account and preferences.
           </p>
         </CardHeader>
-        <CardContent>
-          <p className="text-muted-foreground text-sm">
-            Settings options will appear here.
+        <CardContent className="space-y-4">
+          <div>
+            <h2 className="font-semibold">Calendar subscription</h2>
+            <p className="text-muted-foreground text-sm">
+              Subscribe to this link in Google Calendar, Apple Calendar or Outlook to see every event you
+              host or have confirmed. Changes to an event show up when your calendar app next refreshes.
+            </p>
+          </div>
+          <div className="flex gap-2">
+            <Input readOnly value={feed?.url ?? ""} className="rounded-lg h-10 font-mono text-xs" />
+            <Button
+              variant="outline"
+              disabled={!feed}
+              onClick={() => {
+                if (!feed) return;
+                navigator.clipboard.writeText(feed.url);
+                setCopied(true);
+                setTimeout(() => setCopied(false), 2000);
+              }}
+              className="rounded-lg h-10"
+            >
+              <Copy className="size-4 mr-2" />
+              {copied ? "Copied" : "Copy"}
+            </Button>
+          </div>
+          <div className="flex flex-wrap gap-2">
+            {feed && (
+              <Button variant="outline" size="sm" asChild className="rounded-lg">
+                <a href={feed.webcal_url}>
+                  <CalendarPlus className="size-4 mr-2" />
+                  Open in calendar app
+                </a>
+              </Button>
+            )}
+            <Button
+              variant="ghost"
+              size="sm"
+              disabled={isResetting}
+              onClick={() => resetFeed().catch(() => {})}
+              className="rounded-lg"
+              title="Creates a new link; calendars subscribed to the old one stop updating"
+            >
+              <RefreshCw className="size-4 mr-2" />
+              Reset link
+            </Button>
+          </div>
+          <p className="text-muted-foreground text-xs">
+            Keep this link private: anyone who has it can see your events. Reset it if it has been shared.
           </p>
         </CardContent>
       </Card>
@@ -0,0 +1,77 @@
+"use client";
+
+import type { RsvpAnswerRequest, RsvpQuestionResponse } from "@/lib/api/eventsApi";
+
+type RsvpQuestionFieldsProps = {
+  /** Prefixes input ids so the same questions can be shown for several party members. */
+  idPrefix: string;
+  questions: RsvpQuestionResponse[];
+  value: Record<string, RsvpAnswerRequest>;
+  onChange: (questionId: string, answer: RsvpAnswerRequest) => void;
+};
+
+/** Inputs for one party member's answers to the event's RSVP questions. */
+export function RsvpQuestionFields({ idPrefix, questions, value, onChange }: RsvpQuestionFieldsProps) {
+  return (
+    <div className="space-y-4">
+      {questions.map((q) => {
+        const inputId = `${idPrefix}-${q.id}`;
+        const answer = value[q.id] ?? { question_id: q.id };
+        const choices = answer.choices ?? [];
+        return (
+          <div key={q.id} className="space-y-1.5">
+            <label htmlFor={inputId} className="block text-sm text-slate-700 dark:text-slate-300">
+              {q.prompt}
+              {q.required && <span className="text-destructive"> *</span>}
+            </label>
+            {q.kind === "text" && (
+              <input
+                id={inputId}
+                type="text"
+                value={answer.text ?? ""}
+                onChange={(e) => onChange(q.id, { question_id: q.id, text: e.target.value })}
+                className="w-full rounded-lg border-slate-300 dark:border-slate-600 bg-white dark:bg-slate-900 text-sm focus:ring-2 focus:ring-primary/30 focus:border-primary"
+              />
+            )}
+            {q.kind === "single_choice" && (
+              <select
+                id={inputId}
+                value={choices[0] ?? ""}
+                onChange={(e) =>
+                  onChange(q.id, { question_id: q.id, choices: e.target.value ? [e.target.value] : [] })
+                }
+                className="w-full rounded-lg border-slate-300 dark:border-slate-600 bg-white dark:bg-slate-900 text-sm focus:ring-2 focus:ring-primary/30 focus:border-primary"
+              >
+                <option value="">Choose…</option>
+                {q.options.map((o) => (
+                  <option key={o} value={o}>
+                    {o}
+                  </option>
+                ))}
+              </select>
+            )}
+            {q.kind === "multi_choice" && (
+              <div id={inputId} className="flex flex-wrap gap-x-5 gap-y-2">
+                {q.options.map((o) => (
+                  <label key={o} className="inline-flex items-center gap-2 text-sm text-slate-600 dark:text-slate-400">
+                    <input
+                      type="checkbox"
+                      checked={choices.includes(o)}
+                      onChange={(e) =>
+                        onChange(q.id, {
+                          question_id: q.id,
+                          choices: e.target.checked ? [...choices, o] : choices.filter((c) => c !== o),
+                        })
+                      }
+                    />
+                    {o}
+                  </label>
+                ))}
+              </div>
+            )}
+          </div>
+        );
+      })}
+    </div>
+  );
+}
@@ -0,0 +1,201 @@
+"use client";
+
+import { useState } from "react";
+import {
+  useCreateRsvpQuestionMutation,
+  useDeleteRsvpQuestionMutation,
+  useGetRsvpQuestionsQuery,
+  useGetRsvpSummaryQuery,
+  type RsvpQuestionKind,
+} from "@/lib/api/eventsApi";
+import { Button } from "@/components/ui/button";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Plus, Trash2 } from "lucide-react";
+
+const kindLabel: Record<RsvpQuestionKind, string> = {
+  text: "Free text",
+  single_choice: "Single choice",
+  multi_choice: "Multiple choice",
+};
+
+type RsvpQuestionsPanelProps = {
+  eventId: string;
+};
+
+/** Organizer view of the RSVP questionnaire: add or remove questions and see the answers tallied. */
+export function RsvpQuestionsPanel({ eventId }: RsvpQuestionsPanelProps) {
+  const { data } = useGetRsvpQuestionsQuery(eventId);
+  const { data: summary } = useGetRsvpSummaryQuery(eventId);
+  const [createQuestion, { isLoading: isCreating, error: createError }] = useCreateRsvpQuestionMutation();
+  const [deleteQuestion] = useDeleteRsvpQuestionMutation();
+
+  const [prompt, setPrompt] = useState("");
+  const [kind, setKind] = useState<RsvpQuestionKind>("text");
+  const [options, setOptions] = useState("");
+  const [required, setRequired] = useState(false);
+  const [perCompanion, setPerCompanion] = useState(false);
+
+  const questions = data?.questions ?? [];
+  const summaryById = new Map((summary?.questions ?? []).map((s) => [s.question.id, s]));
+
+  const handleAdd = (e: React.FormEvent) => {
+    e.preventDefault();
+    createQuestion({
+      eventId,
+      body: {
+        prompt: prompt.trim(),
+        kind,
+        options:
+          kind === "text"
+            ? []
+            : options
+                .split("\n")
+                .map((o) => o.trim())
+                .filter(Boolean),
+        required,
+        per_companion: perCompanion,
+      },
+    })
+      .unwrap()
+      .then(() => {
+        setPrompt("");
+        setOptions("");
+        setRequired(false);
+        setPerCompanion(false);
+      })
+      .catch(() => {});
+  };
+
+  return (
+    <div className="border-t border-slate-200/80 dark:border-slate-700/80">
+      <div className="px-6 py-5 border-b border-slate-200/80 dark:border-slate-700/80">
+        <h2 className="text-lg font-semibold text-slate-900 dark:text-white tracking-tight">RSVP Questions</h2>
+        <p className="text-sm text-muted-foreground mt-0.5">
+          Ask guests about meals, dietary needs or song requests. Answers from confirmed guests are tallied below.
+        </p>
+      </div>
+      <form onSubmit={handleAdd} className="p-6 border-b border-slate-200/80 dark:border-slate-700/80 space-y-4">
+        <div className="grid sm:grid-cols-[1fr_12rem] gap-4">
+          <div className="space-y-2">
+            <Label htmlFor="question-prompt" className="text-sm font-semibold">Question</Label>
+            <Input
+              id="question-prompt"
+              placeholder="Meal choice"
+              value={prompt}
+              onChange={(e) => setPrompt(e.target.value)}
+              className="rounded-lg h-10"
+            />
+          </div>
+          <div className="space-y-2">
+            <Label htmlFor="question-kind" className="text-sm font-semibold">Answer type</Label>
+            <select
+              id="question-kind"
+              value={kind}
+              onChange={(e) => setKind(e.target.value as RsvpQuestionKind)}
+              className="w-full rounded-lg h-10 border-slate-300 dark:border-slate-600 bg-white dark:bg-slate-900 text-sm"
+            >
+              {(Object.keys(kindLabel) as RsvpQuestionKind[]).map((k) => (
+                <option key={k} value={k}>
+                  {kindLabel[k]}
+                </option>
+              ))}
+            </select>
+          </div>
+        </div>
+        {kind !== "text" && (
+          <div className="space-y-2">
+            <Label htmlFor="question-options" className="text-sm font-semibold">Options (one per line)</Label>
+            <textarea
+              id="question-options"
+              rows={3}
+              value={options}
+              onChange={(e) => setOptions(e.target.value)}
+              placeholder={"Chicken\nFish\nVegetarian"}
+              className="w-full resize-none rounded-lg border-slate-300 dark:border-slate-600 bg-white dark:bg-slate-900 text-sm focus:ring-2 focus:ring-primary/30 focus:border-primary"
+            />
+          </div>
+        )}
+        <div className="flex flex-wrap items-center gap-6">
+          <label className="inline-flex items-center gap-2 text-sm text-slate-600 dark:text-slate-400">
+            <input type="checkbox" checked={required} onChange={(e) => setRequired(e.target.checked)} />
+            Required
+          </label>
+          <label className="inline-flex items-center gap-2 text-sm text-slate-600 dark:text-slate-400">
+            <input type="checkbox" checked={perCompanion} onChange={(e) => setPerCompanion(e.target.checked)} />
+            Ask each guest in the party
+          </label>
+          <Button
+            type="submit"
+            disabled={isCreating || !prompt.trim()}
+            className="ml-auto bg-primary text-primary-foreground hover:bg-primary/90 rounded-lg font-semibold flex items-center gap-2 h-10 px-6"
+          >
+            <Plus className="size-4" /> Add Question
+          </Button>
+        </div>
+        {createError && (
+          <p className="text-destructive text-sm">
+            {"data" in createError && typeof (createError as { data?: { error?: string } }).data?.error === "string"
+              ? (createError as { data: { error: string } }).data.error
+              : "Failed to add question"}
+          </p>
+        )}
+      </form>
+      {questions.length === 0 ? (
+        <p className="px-6 py-10 text-center text-muted-foreground text-sm">No RSVP questions yet.</p>
+      ) : (
+        <ul className="divide-y divide-slate-100/80 dark:divide-slate-700/80">
+          {questions.map((q) => {
+            const s = summaryById.get(q.id);
+            return (
+              <li key={q.id} className="px-6 py-4 space-y-2">
+                <div className="flex items-start justify-between gap-4">
+                  <div>
+                    <p className="font-medium text-slate-900 dark:text-slate-100">
+                      {q.prompt}
+                      {q.required && <span className="text-destructive"> *</span>}
+                    </p>
+                    <p className="text-xs text-muted-foreground">
+                      {kindLabel[q.kind]}
+                      {q.per_companion ? " · asked of each guest in the party" : ""}
+                      {s ? ` · ${s.answered} answered, ${s.unanswered} not yet` : ""}
+                    </p>
+                  </div>
+                  <button
+                    type="button"
+                    onClick={() => deleteQuestion({ eventId, questionId: q.id })}
+                    className="text-slate-400 hover:text-red-600"
+                    title="Delete question"
+                  >
+                    <Trash2 className="size-4" />
+                  </button>
+                </div>
+                {s?.options && (
+                  <div className="flex flex-wrap gap-2">
+                    {s.options.map((o) => (
+                      <span
+                        key={o.option}
+                        className="rounded-full bg-slate-100 dark:bg-slate-800 px-3 py-1 text-xs text-slate-600 dark:text-slate-300"
+                      >
+                        {o.option}: {o.count}
+                      </span>
+                    ))}
+                  </div>
+                )}
+                {s?.responses && (
+                  <ul className="space-y-1 text-sm text-slate-600 dark:text-slate-400">
+                    {s.responses.map((r, i) => (
+                      <li key={`${r.invite_id}-${i}`}>
+                        <span className="font-medium">{r.companion_name ?? r.email.split("@")[0]}:</span> {r.text}
+                      </li>
+                    ))}
+                  </ul>
+                )}
+              </li>
+            );
+          })}
+        </ul>
+      )}
+    </div>
+  );
+}
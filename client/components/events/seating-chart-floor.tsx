@@ -1,7 +1,7 @@
 "use client";
 
 import { useCallback, useRef, useState } from "react";
-import type { EventTableResponse } from "@/lib/api/eventsApi";
+import type { EventRoomResponse, EventTableResponse, FloorElementResponse } from "@/lib/api/eventsApi";
 
 /** Seat centers on the round table dotted border (circle). % is of container. Order: N, S, E, W, NE, NW, SE, SW. */
 const ROUND_POSITIONS = [
@@ -29,6 +29,13 @@ const RECT_POSITIONS = [
 
 type SeatingChartFloorProps = {
   tables: EventTableResponse[];
+  /** Stage, bar, entrances etc. Positioned by center, sized in % of the floor */
+  elements?: FloorElementResponse[];
+  /** Rooms of the event; only the tables and elements of the selected room are shown */
+  rooms?: EventRoomResponse[];
+  /** Selected room id; defaults to the first room */
+  roomId?: string | null;
+  onRoomChange?: (roomId: string) => void;
   /** For RSVP: when set, seats are clickable */
   selectable?: boolean;
   /** For RSVP: seat ids currently selected (e.g. primary + guest when plus one) */
@@ -46,7 +53,11 @@ type SeatingChartFloorProps = {
 };
 
 export function SeatingChartFloor({
-  tables,
+  tables: allTables,
+  elements: allElements = [],
+  rooms = [],
+  roomId = null,
+  onRoomChange,
   selectable = false,
   selectedSeatIds = [],
   onSeatSelect,
@@ -57,6 +68,15 @@ export function SeatingChartFloor({
   onPositionChange,
 }: SeatingChartFloorProps) {
   const [draggedId, setDraggedId] = useState<string | null>(null);
+  const [localRoomId, setLocalRoomId] = useState<string | null>(null);
+  const activeRoomId = roomId ?? localRoomId ?? rooms[0]?.id ?? null;
+  const inRoom = (id?: string) => !activeRoomId || !id || id === activeRoomId;
+  const tables = allTables.filter((t) => inRoom(t.room_id));
+  const elements = allElements.filter((el) => inRoom(el.room_id));
+  const selectRoom = (id: string) => {
+    setLocalRoomId(id);
+    onRoomChange?.(id);
+  };
   const floorRef = useRef<HTMLDivElement>(null);
   const canMove = showDelete && !!onPositionChange;
   const canReorder = showDelete && !!onReorder && !onPositionChange;
@@ -123,6 +143,8 @@ export function SeatingChartFloor({
   const floorMinHeight = Math.max(380, (maxY / 100) * 1000);
   const floorMinWidth = Math.max(400, (maxX / 100) * 800);
 
+  const hasStage = elements.some((el) => el.kind === "stage");
+
   const renderTableCard = (t: EventTableResponse) => {
     const assigned = t.seats.filter((s) => s.invite_id != null).length;
     const isFull = assigned >= t.capacity;
@@ -249,6 +271,24 @@ export function SeatingChartFloor({
 
   return (
     <div className="rounded-xl border border-gray-200 dark:border-gray-800 bg-white dark:bg-gray-900 shadow-inner overflow-hidden flex flex-col">
+      {rooms.length > 1 && (
+        <div className="flex flex-wrap gap-2 px-4 py-2 border-b border-gray-200 dark:border-gray-800">
+          {rooms.map((room) => (
+            <button
+              key={room.id}
+              type="button"
+              onClick={() => selectRoom(room.id)}
+              className={`px-3 py-1 rounded-lg text-xs font-bold ${
+                room.id === activeRoomId
+                  ? "bg-primary text-primary-foreground"
+                  : "bg-gray-100 dark:bg-gray-800 text-[#617589]"
+              }`}
+            >
+              {room.name}
+            </button>
+          ))}
+        </div>
+      )}
       <div
         className="overflow-auto flex-1 min-h-0 scrollbar-thin"
         style={{ maxHeight: "min(70vh, 600px)" }}
@@ -262,13 +302,15 @@ export function SeatingChartFloor({
             minWidth: floorMinWidth,
           }}
         >
-          <div className="sticky top-0 left-0 right-0 z-20 flex justify-center shrink-0 py-2 px-4 sm:px-6 bg-[#f6f7f8] dark:bg-[#111318]">
-            <div className="w-72 sm:w-96 h-10 sm:h-12 bg-gray-100 dark:bg-gray-800 border border-gray-200 dark:border-gray-700 rounded-xl flex items-center justify-center shadow-sm">
-              <span className="text-[10px] font-bold uppercase tracking-widest text-[#617589]">
-                Main Stage / Head Table
-              </span>
+          {!hasStage && (
+            <div className="sticky top-0 left-0 right-0 z-20 flex justify-center shrink-0 py-2 px-4 sm:px-6 bg-[#f6f7f8] dark:bg-[#111318]">
+              <div className="w-72 sm:w-96 h-10 sm:h-12 bg-gray-100 dark:bg-gray-800 border border-gray-200 dark:border-gray-700 rounded-xl flex items-center justify-center shadow-sm">
+                <span className="text-[10px] font-bold uppercase tracking-widest text-[#617589]">
+                  Main Stage / Head Table
+                </span>
+              </div>
             </div>
-          </div>
+          )}
           <div
             ref={floorRef}
             className="relative flex-1 w-full pb-8"
@@ -276,6 +318,21 @@ export function SeatingChartFloor({
             onDragOver={handleFloorDragOver}
             onDrop={handleFloorDrop}
           >
+            {elements.map((el) => (
+              <div
+                key={el.id}
+                className="absolute z-0 flex items-center justify-center rounded-md border border-gray-300 dark:border-gray-700 bg-gray-200/80 dark:bg-gray-800/80 pointer-events-none"
+                style={{
+                  left: `${el.position_x}%`,
+                  top: `${el.position_y}%`,
+                  width: `${el.width}%`,
+                  height: `${el.height}%`,
+                  transform: `translate(-50%, -50%) rotate(${el.rotation}deg)`,
+                }}
+              >
+                <span className="text-[10px] font-bold uppercase tracking-widest text-[#617589]">{el.label}</span>
+              </div>
+            ))}
             {items.map(({ t, x, y }) => (
               <div
                 key={t.id}
@@ -0,0 +1,45 @@
+"use client";
+
+import { useMemo } from "react";
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
+
+/** The viewer's IANA time zone, used as the default for new events. */
+export function browserTimeZone(): string {
+  try {
+    return Intl.DateTimeFormat().resolvedOptions().timeZone || "UTC";
+  } catch {
+    return "UTC";
+  }
+}
+
+function timeZones(current: string): string[] {
+  const intl = Intl as typeof Intl & { supportedValuesOf?: (key: "timeZone") => string[] };
+  const zones = intl.supportedValuesOf ? intl.supportedValuesOf("timeZone") : [];
+  return Array.from(new Set(["UTC", current, ...zones])).filter(Boolean);
+}
+
+type TimezoneSelectProps = {
+  id?: string;
+  value: string;
+  onChange: (value: string) => void;
+  className?: string;
+};
+
+/** Picks the IANA time zone that an event's date and times are in. */
+export function TimezoneSelect({ id, value, onChange, className }: TimezoneSelectProps) {
+  const zones = useMemo(() => timeZones(value), [value]);
+  return (
+    <Select value={value} onValueChange={onChange}>
+      <SelectTrigger id={id} className={className}>
+        <SelectValue placeholder="Time zone" />
+      </SelectTrigger>
+      <SelectContent className="max-h-72">
+        {zones.map((zone) => (
+          <SelectItem key={zone} value={zone}>
+            {zone.replace(/_/g, " ")}
+          </SelectItem>
+        ))}
+      </SelectContent>
+    </Select>
+  );
+}
@@ -24,11 +24,13 @@ export type UpdateProfileRequest = {
   phone: string;
   avatar_url: string;
 };
+/** Private calendar subscription URL; anyone holding it can read the user's events. */
+export type CalendarFeedResponse = { url: string; webcal_url: string };
 
 export const authApi = createApi({
   reducerPath: "authApi",
   baseQuery: axiosBaseQuery(),
-  tagTypes: ["Profile"],
+  tagTypes: ["Profile", "CalendarFeed"],
   endpoints: (builder) => ({
     register: builder.mutation<AuthResponse, RegisterRequest>({
       query: (body) => ({ url: "/api/v1/auth/register", method: "POST", body }),
@@ -48,6 +50,14 @@ export const authApi = createApi({
       }),
       invalidatesTags: ["Profile"],
     }),
+    getCalendarFeed: builder.query<CalendarFeedResponse, void>({
+      query: () => "/api/v1/users/me/calendar",
+      providesTags: ["CalendarFeed"],
+    }),
+    resetCalendarFeed: builder.mutation<CalendarFeedResponse, void>({
+      query: () => ({ url: "/api/v1/users/me/calendar/reset", method: "POST" }),
+      invalidatesTags: ["CalendarFeed"],
+    }),
   }),
 });
 
@@ -56,4 +66,6 @@ export const {
   useLoginMutation,
   useGetProfileQuery,
   useUpdateProfileMutation,
+  useGetCalendarFeedQuery,
+  useResetCalendarFeedMutation,
 } = authApi;
\ No newline at end of file
@@ -21,6 +21,8 @@ export type GuestStatsResponse = {
   confirmed: number;
   pending: number;
   declined: number;
+  /** Said yes while the event was full; not counted as pending. */
+  waitlisted: number;
 };
 
 export type MyRecentRSVPItem = {
@@ -37,6 +39,8 @@ export type DashboardResponse = {
   confirmed: number;
   pending: number;
   declined: number;
+  /** Said yes while the event was full; not counted as pending. */
+  waitlisted: number;
   recent_rsvps: RecentRSVPItem[];
   upcoming_event: DashboardEventSummary | null;
   guest_stats?: GuestStatsResponse | null;
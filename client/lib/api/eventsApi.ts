@@ -10,9 +10,16 @@ export type CreateEventRequest = {
   event_date: string;
   start_time: string;
   end_time: string;
+  /** IANA time zone the date and times are in; defaults to UTC. */
+  timezone?: string;
   location: string;
   latitude: number;
   longitude: number;
+  capacity?: number;
+  rsvp_deadline?: string;
+  seat_selection_opens_at?: string;
+  seat_selection_closes_at?: string;
+  seating_locked?: boolean;
 };
 
 export type UpdateEventRequest = CreateEventRequest & { id: string };
@@ -28,9 +35,18 @@ export type EventResponse = {
   event_date: string;
   start_time: string;
   end_time: string;
+  timezone: string;
+  /** RFC 3339 instants of the start and end, for past/upcoming checks. */
+  starts_at: string;
+  ends_at: string;
   location: string;
   latitude: number;
   longitude: number;
+  capacity?: number | null;
+  rsvp_deadline?: string;
+  seat_selection_opens_at?: string;
+  seat_selection_closes_at?: string;
+  seating_locked?: boolean;
   created_at: string;
   updated_at: string;
 };
@@ -42,10 +58,108 @@ export type EventInviteResponse = {
   email: string;
   status: string;
   seat_id?: string | null;
-  guest_seat_id?: string | null;
+  party_size: number;
+  companions: CompanionResponse[];
+  waitlisted_at?: string;
   created_at: string;
 };
 
+export type CompanionResponse = {
+  id: string;
+  name: string;
+  seat_id?: string | null;
+};
+
+/** A companion sent with an RSVP. Omit id to add a new one; omit seat_id to keep their seat. */
+export type CompanionRequest = {
+  id?: string;
+  name: string;
+  seat_id?: string | null;
+  answers?: RsvpAnswerRequest[];
+};
+
+export type RsvpQuestionKind = "text" | "single_choice" | "multi_choice";
+
+export type RsvpQuestionRequest = {
+  prompt: string;
+  kind: RsvpQuestionKind;
+  options: string[];
+  required: boolean;
+  per_companion: boolean;
+  display_order?: number;
+};
+
+export type RsvpQuestionResponse = {
+  id: string;
+  event_id: string;
+  prompt: string;
+  kind: RsvpQuestionKind;
+  options: string[];
+  required: boolean;
+  per_companion: boolean;
+  display_order: number;
+};
+
+export type RsvpAnswerRequest = {
+  question_id: string;
+  text?: string;
+  choices?: string[];
+};
+
+export type RsvpAnswerResponse = RsvpAnswerRequest & {
+  companion_id?: string | null;
+};
+
+export type RsvpQuestionnaireResponse = {
+  questions: RsvpQuestionResponse[];
+  answers: RsvpAnswerResponse[];
+};
+
+export type RsvpQuestionSummary = {
+  question: RsvpQuestionResponse;
+  answered: number;
+  unanswered: number;
+  options?: { option: string; count: number }[];
+  responses?: { invite_id: string; email: string; companion_name?: string; text: string }[];
+};
+
+export type RsvpSummaryResponse = {
+  questions: RsvpQuestionSummary[];
+};
+
+export type CheckInMember = {
+  companion_id?: string | null;
+  name: string;
+  seat_id?: string | null;
+  /** Empty until the member arrives. */
+  checked_in_at?: string;
+};
+
+export type CheckInParty = {
+  invite_id: string;
+  email: string;
+  guest_name: string;
+  members: CheckInMember[];
+};
+
+export type CheckInListResponse = {
+  expected: number;
+  arrived: number;
+  pending: number;
+  no_show: number;
+  parties: CheckInParty[];
+};
+
+/** Either a scanned ticket token or the invite picked from the door list. */
+export type CheckInRequest = {
+  token?: string;
+  invite_id?: string;
+  /** Whether the ticket holder arrived; defaults to true. */
+  include_guest?: boolean;
+  /** Companions who arrived; omit to check in all of them. */
+  companion_ids?: string[];
+};
+
 export type EventSeatResponse = {
   id: string;
   event_table_id: string;
@@ -57,6 +171,7 @@ export type EventSeatResponse = {
 export type EventTableResponse = {
   id: string;
   event_id: string;
+  room_id?: string;
   name: string;
   shape: "round" | "rectangular" | "grid";
   rows?: number | null;
@@ -65,9 +180,43 @@ export type EventTableResponse = {
   display_order: number;
   position_x?: number;
   position_y?: number;
+  width?: number;
+  height?: number;
+  rotation?: number;
   seats: EventSeatResponse[];
 };
 
+export type FloorElementKind = "stage" | "dance_floor" | "bar" | "entrance" | "buffet" | "restroom";
+
+export type FloorElementResponse = {
+  id: string;
+  event_id: string;
+  room_id?: string;
+  kind: FloorElementKind;
+  label: string;
+  position_x: number;
+  position_y: number;
+  width: number;
+  height: number;
+  rotation: number;
+};
+
+export type EventRoomResponse = {
+  id: string;
+  event_id: string;
+  name: string;
+  width: number;
+  height: number;
+  display_order: number;
+  table_count: number;
+};
+
+export type EventSeatingResponse = {
+  rooms: EventRoomResponse[];
+  tables: EventTableResponse[];
+  elements: FloorElementResponse[];
+};
+
 export type PaginatedEventsResponse = { items: EventResponse[]; total: number };
 export type PaginatedInvitationsResponse = { items: InvitationWithEventResponse[]; total: number };
 export type PaginatedInvitesResponse = { items: EventInviteResponse[]; total: number };
@@ -85,6 +234,9 @@ export type UpdateEventTableRequest = {
   capacity?: number;
   position_x?: number;
   position_y?: number;
+  width?: number;
+  height?: number;
+  rotation?: number;
   display_order?: number;
 };
 
@@ -102,7 +254,7 @@ export type ListPublicEventsParams = {
 export const eventsApi = createApi({
   reducerPath: "eventsApi",
   baseQuery: axiosBaseQuery(),
-  tagTypes: ["Events", "Event", "EventInvites"],
+  tagTypes: ["Events", "Event", "EventInvites", "RsvpQuestions", "CheckIns"],
   endpoints: (builder) => ({
     createEvent: builder.mutation<EventResponse, CreateEventRequest>({
       query: (body) => ({ url: "/api/v1/events", method: "POST", body }),
@@ -146,22 +298,77 @@ export const eventsApi = createApi({
     }),
     respondToInvite: builder.mutation<
       EventInviteResponse,
-      { eventId: string; status: "confirmed" | "declined"; seat_id?: string | null; guest_seat_id?: string | null }
+      {
+        eventId: string;
+        status: "confirmed" | "declined";
+        seat_id?: string | null;
+        companions?: CompanionRequest[];
+        answers?: RsvpAnswerRequest[];
+      }
     >({
-      query: ({ eventId, status, seat_id, guest_seat_id }) => ({
+      query: ({ eventId, status, seat_id, companions, answers }) => ({
         url: `/api/v1/events/${eventId}/rsvp`,
         method: "PUT",
-        body: { status, seat_id: seat_id ?? undefined, guest_seat_id: guest_seat_id ?? undefined },
+        body: { status, seat_id: seat_id ?? undefined, companions, answers },
       }),
-      invalidatesTags: ["Events", "EventInvites"],
+      invalidatesTags: ["Events", "EventInvites", "RsvpQuestions"],
+    }),
+    getRsvpQuestions: builder.query<RsvpQuestionnaireResponse, string>({
+      query: (eventId) => `/api/v1/events/${eventId}/questions`,
+      providesTags: ["RsvpQuestions"],
+    }),
+    createRsvpQuestion: builder.mutation<RsvpQuestionResponse, { eventId: string; body: RsvpQuestionRequest }>({
+      query: ({ eventId, body }) => ({ url: `/api/v1/events/${eventId}/questions`, method: "POST", body }),
+      invalidatesTags: ["RsvpQuestions"],
     }),
-    getEventSeating: builder.query<EventTableResponse[], string>({
+    updateRsvpQuestion: builder.mutation<
+      RsvpQuestionResponse,
+      { eventId: string; questionId: string; body: RsvpQuestionRequest }
+    >({
+      query: ({ eventId, questionId, body }) => ({
+        url: `/api/v1/events/${eventId}/questions/${questionId}`,
+        method: "PUT",
+        body,
+      }),
+      invalidatesTags: ["RsvpQuestions"],
+    }),
+    deleteRsvpQuestion: builder.mutation<void, { eventId: string; questionId: string }>({
+      query: ({ eventId, questionId }) => ({
+        url: `/api/v1/events/${eventId}/questions/${questionId}`,
+        method: "DELETE",
+      }),
+      invalidatesTags: ["RsvpQuestions"],
+    }),
+    getRsvpSummary: builder.query<RsvpSummaryResponse, string>({
+      query: (eventId) => `/api/v1/events/${eventId}/questions/summary`,
+      providesTags: ["RsvpQuestions"],
+    }),
+    getCheckIns: builder.query<CheckInListResponse, string>({
+      query: (eventId) => `/api/v1/events/${eventId}/check-ins`,
+      providesTags: ["CheckIns"],
+    }),
+    checkIn: builder.mutation<CheckInParty, { eventId: string; body: CheckInRequest }>({
+      query: ({ eventId, body }) => ({ url: `/api/v1/events/${eventId}/check-ins`, method: "POST", body }),
+      invalidatesTags: ["CheckIns"],
+    }),
+    undoCheckIn: builder.mutation<CheckInParty, { eventId: string; inviteId: string; companionId?: string }>({
+      query: ({ eventId, inviteId, companionId }) => ({
+        url: `/api/v1/events/${eventId}/check-ins/${inviteId}`,
+        method: "DELETE",
+        params: companionId ? { companion_id: companionId } : undefined,
+      }),
+      invalidatesTags: ["CheckIns"],
+    }),
+    getEventSeating: builder.query<EventSeatingResponse, string>({
       query: (eventId) => `/api/v1/events/${eventId}/seating`,
       providesTags: ["EventInvites"],
     }),
-    createEventTable: builder.mutation<EventTableResponse, { eventId: string; body: CreateEventTableRequest }>({
-      query: ({ eventId, body }) => ({
-        url: `/api/v1/events/${eventId}/tables`,
+    createEventTable: builder.mutation<
+      EventTableResponse,
+      { eventId: string; roomId?: string | null; body: CreateEventTableRequest }
+    >({
+      query: ({ eventId, roomId, body }) => ({
+        url: roomId ? `/api/v1/events/${eventId}/rooms/${roomId}/tables` : `/api/v1/events/${eventId}/tables`,
         method: "POST",
         body,
       }),
@@ -206,14 +413,22 @@ export const eventsApi = createApi({
       },
       providesTags: ["Events"],
     }),
-    inviteToEvent: builder.mutation<EventInviteResponse, { eventId: string; email: string }>({
-      query: ({ eventId, email }) => ({
+    inviteToEvent: builder.mutation<EventInviteResponse, { eventId: string; email: string; party_size?: number }>({
+      query: ({ eventId, email, party_size }) => ({
         url: `/api/v1/events/${eventId}/invites`,
         method: "POST",
-        body: { email },
+        body: { email, party_size },
       }),
       invalidatesTags: ["Events", "EventInvites"],
     }),
+    updateInvite: builder.mutation<EventInviteResponse, { eventId: string; inviteId: string; party_size: number }>({
+      query: ({ eventId, inviteId, party_size }) => ({
+        url: `/api/v1/events/${eventId}/invites/${inviteId}`,
+        method: "PUT",
+        body: { party_size },
+      }),
+      invalidatesTags: ["EventInvites"],
+    }),
     getEventInvites: builder.query<PaginatedInvitesResponse, { eventId: string; limit?: number; offset?: number }>({
       query: ({ eventId, limit = 50, offset = 0 }) =>
         `/api/v1/events/${eventId}/invites?limit=${limit}&offset=${offset}`,
@@ -233,6 +448,15 @@ export const {
   useRespondToInviteMutation,
   useGetPublicEventsQuery,
   useInviteToEventMutation,
+  useUpdateInviteMutation,
+  useGetRsvpQuestionsQuery,
+  useCreateRsvpQuestionMutation,
+  useUpdateRsvpQuestionMutation,
+  useDeleteRsvpQuestionMutation,
+  useGetRsvpSummaryQuery,
+  useGetCheckInsQuery,
+  useCheckInMutation,
+  useUndoCheckInMutation,
   useGetEventInvitesQuery,
   useGetEventSeatingQuery,
   useCreateEventTableMutation,
@@ -1,8 +1,12 @@
 import { createApi } from "@reduxjs/toolkit/query/react";
 import { axiosBaseQuery } from "./axiosBaseQuery";
+import { api } from "./axios";
 
 export type TicketResponse = {
   ticket_id: string;
+  /** Signed ticket token; this is what the QR code encodes. */
+  token: string;
+  expires_at: string;
   guest_name: string;
   event_name: string;
   event_date: string;
@@ -24,3 +28,30 @@ export const ticketApi = createApi({
 });
 
 export const { useGetTicketQuery } = ticketApi;
+
+export type TicketFormat = "png" | "pdf";
+
+/** Downloads the server-rendered ticket; organizers pass inviteId to get a guest's ticket. */
+export async function downloadTicket(eventId: string, format: TicketFormat, inviteId?: string) {
+  const res = await api.get<Blob>(`/api/v1/events/${eventId}/ticket.${format}`, {
+    params: inviteId ? { invite_id: inviteId } : undefined,
+    responseType: "blob",
+  });
+  const url = URL.createObjectURL(res.data);
+  const link = document.createElement("a");
+  link.download = `ticket.${format}`;
+  link.href = url;
+  link.click();
+  URL.revokeObjectURL(url);
+}
+
+/** Downloads the event as an .ics file for adding to a calendar app. */
+export async function downloadEventCalendar(eventId: string) {
+  const res = await api.get<Blob>(`/api/v1/events/${eventId}/calendar.ics`, { responseType: "blob" });
+  const url = URL.createObjectURL(res.data);
+  const link = document.createElement("a");
+  link.download = "event.ics";
+  link.href = url;
+  link.click();
+  URL.revokeObjectURL(url);
+}
@@ -0,0 +1,56 @@
+"use client";
+
+import { useEffect, useRef } from "react";
+
+const baseURL =
+  typeof process !== "undefined"
+    ? process.env.NEXT_PUBLIC_API_URL || "http://localhost:8080"
+    : "http://localhost:8080";
+
+function getWsBaseUrl(): string {
+  const url = baseURL.replace(/^http/, "ws");
+  return url.replace(/\/$/, "");
+}
+
+export type SeatingEventWS = {
+  type:
+    | "seat_claimed"
+    | "seat_released"
+    | "table_added"
+    | "table_moved"
+    | "table_updated"
+    | "table_removed"
+    | "tables_reordered"
+    | "seating_changed";
+  event_id: string;
+  seat_id?: string;
+  invite_id?: string;
+  table_id?: string;
+  table_ids?: string[];
+  data?: unknown;
+};
+
+// Subscribes to live seating changes of an event. The token is optional for public events.
+export function useSeatingWebSocket(
+  eventId: string | null,
+  token: string | null,
+  onChange: (event: SeatingEventWS) => void
+) {
+  const onChangeRef = useRef(onChange);
+  onChangeRef.current = onChange;
+
+  useEffect(() => {
+    if (!eventId) return;
+    const query = token ? `?token=${encodeURIComponent(token)}` : "";
+    const ws = new WebSocket(`${getWsBaseUrl()}/api/v1/ws/events/${eventId}/seating${query}`);
+    ws.onmessage = (event) => {
+      try {
+        const data = JSON.parse(event.data) as SeatingEventWS;
+        if (data.type) onChangeRef.current(data);
+      } catch {
+        // ignore non-JSON or invalid
+      }
+    };
+    return () => ws.close();
+  }, [eventId, token]);
+}
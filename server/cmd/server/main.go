@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
+	"time"
+	_ "time/tzdata" // event time zones resolve even on hosts without a zoneinfo database
 
 	"github.com/KalebAsratemedhin/seatmaster/internal/application/usecases"
 	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/database"
@@ -14,6 +17,7 @@ import (
 	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/mail"
 	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/repositories"
 	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/security"
+	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/tickets"
 
 	"github.com/joho/godotenv"
 )
@@ -39,23 +43,50 @@ func main() {
 		log.Fatal("Failed to initialize JWT manager:", err)
 	}
 	passwordManager := security.NewPasswordManager()
+	ticketSigner, err := security.NewTicketSigner()
+	if err != nil {
+		log.Fatal("Failed to initialize ticket signer:", err)
+	}
 
 	userRepo := repositories.NewUserRepository(db.GetDB())
 	eventRepo := repositories.NewEventRepository(db.GetDB())
 	eventInviteRepo := repositories.NewEventInviteRepository(db.GetDB())
+	eventRoomRepo := repositories.NewEventRoomRepository(db.GetDB())
 	eventTableRepo := repositories.NewEventTableRepository(db.GetDB())
 	eventSeatRepo := repositories.NewEventSeatRepository(db.GetDB())
 	eventCommentRepo := repositories.NewEventCommentRepository(db.GetDB())
 	eventChatThreadRepo := repositories.NewEventChatThreadRepository(db.GetDB())
 	eventChatMessageRepo := repositories.NewEventChatMessageRepository(db.GetDB())
+	seatingConstraintRepo := repositories.NewSeatingConstraintRepository(db.GetDB())
+	floorElementRepo := repositories.NewFloorElementRepository(db.GetDB())
+	venueLayoutRepo := repositories.NewVenueLayoutRepository(db.GetDB())
+	seatingSnapshotRepo := repositories.NewSeatingSnapshotRepository(db.GetDB())
+	rsvpQuestionRepo := repositories.NewRSVPQuestionRepository(db.GetDB())
+	eventCheckInRepo := repositories.NewEventCheckInRepository(db.GetDB())
+	transactor := repositories.NewTransactor(db.GetDB())
+
+	uploadDir := os.Getenv("UPLOAD_DIR")
+	if uploadDir == "" {
+		uploadDir = "uploads"
+	}
+	baseURL := os.Getenv("BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:8080"
+	}
 
 	authUseCase := usecases.NewAuthUseCase(userRepo, jwtManager, passwordManager)
 	mailer := mail.NewSMTPMailer()
-	eventUseCase := usecases.NewEventUseCase(eventRepo, eventInviteRepo, eventTableRepo, eventSeatRepo, userRepo, mailer)
+	seatingHub := ws.NewSeatingHub()
+	ticketRenderer := tickets.NewRenderer(uploadDir, baseURL)
+	eventUseCase := usecases.NewEventUseCase(eventRepo, eventInviteRepo, eventRoomRepo, eventTableRepo, eventSeatRepo, floorElementRepo, seatingSnapshotRepo, rsvpQuestionRepo, eventCheckInRepo, userRepo, transactor, mailer, seatingHub, ticketSigner, ticketRenderer)
+	go eventUseCase.RunSeatHoldExpiry(context.Background(), time.Minute)
 	dashboardUseCase := usecases.NewDashboardUseCase(eventRepo, eventInviteRepo, userRepo)
 	profileUseCase := usecases.NewProfileUseCase(userRepo, authUseCase)
 	commentUseCase := usecases.NewCommentUseCase(eventRepo, eventInviteRepo, eventCommentRepo, userRepo)
 	chatUseCase := usecases.NewChatUseCase(eventRepo, eventInviteRepo, eventChatThreadRepo, eventChatMessageRepo, userRepo)
+	seatingConstraintUseCase := usecases.NewSeatingConstraintUseCase(eventRepo, eventInviteRepo, seatingConstraintRepo, eventUseCase)
+	calendarUseCase := usecases.NewCalendarUseCase(eventRepo, eventInviteRepo, userRepo, baseURL, os.Getenv("FRONTEND_URL"))
+	venueLayoutUseCase := usecases.NewVenueLayoutUseCase(eventRepo, eventRoomRepo, eventTableRepo, eventSeatRepo, floorElementRepo, venueLayoutRepo, transactor, eventUseCase)
 
 	authHandler := handlers.NewAuthHandler(authUseCase)
 	eventHandler := handlers.NewEventHandler(eventUseCase)
@@ -63,22 +94,18 @@ func main() {
 	profileHandler := handlers.NewProfileHandler(profileUseCase)
 	commentHandler := handlers.NewCommentHandler(commentUseCase)
 	chatHandler := handlers.NewChatHandler(chatUseCase)
+	seatingConstraintHandler := handlers.NewSeatingConstraintHandler(seatingConstraintUseCase)
+	venueLayoutHandler := handlers.NewVenueLayoutHandler(venueLayoutUseCase)
+	calendarHandler := handlers.NewCalendarHandler(calendarUseCase)
 	chatHub := ws.NewHub()
 	chatWSHandler := handlers.NewChatWSHandler(chatUseCase, jwtManager, chatHub)
+	seatingWSHandler := handlers.NewSeatingWSHandler(eventUseCase, jwtManager, seatingHub)
 
-	uploadDir := os.Getenv("UPLOAD_DIR")
-	if uploadDir == "" {
-		uploadDir = "uploads"
-	}
-	baseURL := os.Getenv("BASE_URL")
-	if baseURL == "" {
-		baseURL = "http://localhost:8080"
-	}
 	uploadHandler := handlers.NewUploadHandler(uploadDir, baseURL)
 
 	authMiddleware := middleware.NewAuthMiddleware(jwtManager)
 
-	router := httpHandler.NewRouter(authHandler, eventHandler, uploadHandler, profileHandler, commentHandler, chatHandler, chatWSHandler, dashboardHandler, authMiddleware)
+	router := httpHandler.NewRouter(authHandler, eventHandler, uploadHandler, profileHandler, commentHandler, chatHandler, chatWSHandler, seatingWSHandler, dashboardHandler, seatingConstraintHandler, venueLayoutHandler, calendarHandler, authMiddleware)
 	muxRouter := router.SetupRoutes(uploadDir)
 	handler := middleware.CORS(muxRouter)
 
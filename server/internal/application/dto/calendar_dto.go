@@ -0,0 +1,7 @@
+package dto
+
+// CalendarFeedResponse is the user's private calendar subscription URL.
+type CalendarFeedResponse struct {
+	URL       string `json:"url"`
+	WebcalURL string `json:"webcal_url"`
+}
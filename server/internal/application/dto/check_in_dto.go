@@ -0,0 +1,60 @@
+package dto
+
+// CheckInRequest checks in the party behind a signed ticket token, as scanned from its QR code, or
+// the invite the organizer picked from the door list. IncludeGuest says whether the ticket holder
+// arrived; nil counts as true. CompanionIDs lists the companions who arrived; nil checks in all of them.
+type CheckInRequest struct {
+	Token        string   `json:"token,omitempty"`
+	InviteID     string   `json:"invite_id,omitempty"`
+	IncludeGuest *bool    `json:"include_guest,omitempty"`
+	CompanionIDs []string `json:"companion_ids,omitempty"`
+}
+
+// CheckInPartyResponse is a confirmed invite's party with the arrival of each member.
+type CheckInPartyResponse struct {
+	InviteID  string                   `json:"invite_id"`
+	Email     string                   `json:"email"`
+	GuestName string                   `json:"guest_name"`
+	Members   []*CheckInMemberResponse `json:"members"` // the guest first, then the companions
+}
+
+// CheckInMemberResponse is one party member; CompanionID is nil for the guest. CheckedInAt is empty
+// until the member arrives.
+type CheckInMemberResponse struct {
+	CompanionID *string `json:"companion_id,omitempty"`
+	Name        string  `json:"name"`
+	SeatID      *string `json:"seat_id,omitempty"`
+	CheckedInAt string  `json:"checked_in_at,omitempty"`
+}
+
+// CheckInListResponse is the door list of an event. Expected counts confirmed guests and companions;
+// NoShow counts those who have not arrived, once the event is over.
+type CheckInListResponse struct {
+	Expected int                     `json:"expected"`
+	Arrived  int                     `json:"arrived"`
+	Pending  int                     `json:"pending"`
+	NoShow   int                     `json:"no_show"`
+	Parties  []*CheckInPartyResponse `json:"parties"`
+}
+
+// VerifyTicketRequest carries a signed ticket token as read from its QR code.
+type VerifyTicketRequest struct {
+	Token string `json:"token"`
+}
+
+// TicketVerificationResponse is a valid ticket's claims with the party's current check-in state.
+type TicketVerificationResponse struct {
+	EventID   string                `json:"event_id"`
+	InviteID  string                `json:"invite_id"`
+	PartySize int                   `json:"party_size"`
+	ExpiresAt string                `json:"expires_at"`
+	Party     *CheckInPartyResponse `json:"party"`
+}
+
+// TicketPublicKeyResponse is the ticket verification key, as PEM and as a JSON Web Key.
+type TicketPublicKeyResponse struct {
+	KeyID     string            `json:"key_id"`
+	Algorithm string            `json:"algorithm"`
+	PEM       string            `json:"pem"`
+	JWK       map[string]string `json:"jwk"`
+}
@@ -7,6 +7,7 @@ type DashboardResponse struct {
 	Confirmed        int64                   `json:"confirmed"`
 	Pending          int64                   `json:"pending"`
 	Declined         int64                   `json:"declined"`
+	Waitlisted       int64                   `json:"waitlisted"` // said yes while the event was full
 	RecentRSVPs      []*RecentRSVPItem       `json:"recent_rsvps"`
 	UpcomingEvent    *DashboardEventSummary  `json:"upcoming_event,omitempty"`
 	GuestStats       *GuestStatsResponse     `json:"guest_stats,omitempty"`
@@ -19,6 +20,7 @@ type GuestStatsResponse struct {
 	Confirmed int64 `json:"confirmed"`
 	Pending   int64 `json:"pending"`
 	Declined  int64 `json:"declined"`
+	Waitlisted int64 `json:"waitlisted"`
 }
 
 // MyRecentRSVPItem is one row for "events I recently RSVP'd to".
@@ -36,7 +38,7 @@ type RecentRSVPItem struct {
 	EventName    string `json:"event_name"`
 	EventID      string `json:"event_id"`
 	Status       string `json:"status"`
-	PlusOne      string `json:"plus_one"` // "Yes (1)", "No", "-"
+	PlusOne      string `json:"plus_one"` // "Yes (n)" with n companions, "No", "-"
 	ResponseTime string `json:"response_time"`
 }
 
@@ -49,11 +51,22 @@ type DashboardEventSummary struct {
 
 // TicketResponse is returned by GET /events/:id/ticket for a confirmed guest.
 type TicketResponse struct {
-	TicketID   string         `json:"ticket_id"`   // invite ID, encode in QR for check-in
+	TicketID   string         `json:"ticket_id"`   // invite ID
+	Token      string         `json:"token"`       // signed ticket, encode in QR for check-in
+	ExpiresAt  string         `json:"expires_at"`
 	GuestName  string         `json:"guest_name"`
 	EventName  string         `json:"event_name"`
 	EventDate  string         `json:"event_date"`
 	StartTime  string         `json:"start_time"`
 	EndTime    string         `json:"end_time"`
 	Location   string         `json:"location"`
+	BannerURL  string         `json:"banner_url,omitempty"`
+	Seats      []*TicketSeatResponse `json:"seats"` // the guest first, then their companions
+}
+
+// TicketSeatResponse is where one member of the party sits; Table and Seat are empty while unseated.
+type TicketSeatResponse struct {
+	Name  string `json:"name"`
+	Table string `json:"table,omitempty"`
+	Seat  string `json:"seat,omitempty"`
 }
@@ -12,6 +12,13 @@ type CreateEventRequest struct {
 	Location  string `json:"location"`
 	Latitude  float64 `json:"latitude"`
 	Longitude  float64 `json:"longitude"`
+	Timezone   string  `json:"timezone"` // IANA name, e.g. "Africa/Addis_Ababa"; defaults to UTC
+	Capacity   *int    `json:"capacity,omitempty"` // max attendees including companions; omit for no limit
+	// RSVPDeadline and the seat-selection window are RFC 3339 timestamps; omit for no limit.
+	RSVPDeadline          string `json:"rsvp_deadline,omitempty"`
+	SeatSelectionOpensAt  string `json:"seat_selection_opens_at,omitempty"`
+	SeatSelectionClosesAt string `json:"seat_selection_closes_at,omitempty"`
+	SeatingLocked         bool   `json:"seating_locked"`
 }
 type UpdateEventRequest struct {
 	ID        string `json:"id"`
@@ -26,6 +33,27 @@ type UpdateEventRequest struct {
 	Location  string `json:"location"`
 	Latitude  float64 `json:"latitude"`
 	Longitude  float64 `json:"longitude"`
+	Timezone   string  `json:"timezone"` // omit to keep the current time zone
+	Capacity   *int    `json:"capacity,omitempty"` // omit to remove the limit
+	RSVPDeadline          string `json:"rsvp_deadline,omitempty"`
+	SeatSelectionOpensAt  string `json:"seat_selection_opens_at,omitempty"`
+	SeatSelectionClosesAt string `json:"seat_selection_closes_at,omitempty"`
+	SeatingLocked         *bool  `json:"seating_locked,omitempty"` // omit to keep the current lock
+}
+
+// SeatingLockRequest freezes or unfreezes guest seat changes.
+type SeatingLockRequest struct {
+	Locked bool `json:"locked"`
+}
+
+// DuplicateEventRequest copies an event to EventDate (YYYY-MM-DD). Name defaults to "<name> (<date>)".
+// IncludeInvites copies the guest list as pending invites without seats. The copied guests are only
+// emailed when NotifyInvites is set, so the organizer can edit the copy before anyone hears of it.
+type DuplicateEventRequest struct {
+	Name           string `json:"name"`
+	EventDate      string `json:"event_date"`
+	IncludeInvites bool   `json:"include_invites"`
+	NotifyInvites  bool   `json:"notify_invites"`
 }
 
 type EventResponse struct {
@@ -42,13 +70,22 @@ type EventResponse struct {
 	Location  string `json:"location"`
 	Latitude  float64 `json:"latitude"`
 	Longitude  float64 `json:"longitude"`
+	Timezone   string  `json:"timezone"`
+	StartsAt   string  `json:"starts_at"` // RFC 3339 instants of the start and end in Timezone
+	EndsAt     string  `json:"ends_at"`
+	Capacity   *int    `json:"capacity,omitempty"`
+	RSVPDeadline          string `json:"rsvp_deadline,omitempty"`
+	SeatSelectionOpensAt  string `json:"seat_selection_opens_at,omitempty"`
+	SeatSelectionClosesAt string `json:"seat_selection_closes_at,omitempty"`
+	SeatingLocked         bool   `json:"seating_locked"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
 
 // InviteEventRequest is the body for inviting a user to an event by email.
 type InviteEventRequest struct {
-	Email string `json:"email"`
+	Email     string `json:"email"`
+	PartySize int    `json:"party_size,omitempty"` // the guest plus allowed companions; defaults to 2
 }
 
 // EventInviteResponse is returned when listing invites for an event.
@@ -58,9 +95,23 @@ type EventInviteResponse struct {
 	UserID       string  `json:"user_id"` // empty when invite-by-email only
 	Email        string  `json:"email"`
 	Status       string  `json:"status"`
-	SeatID       *string `json:"seat_id,omitempty"`
-	GuestSeatID  *string `json:"guest_seat_id,omitempty"`
-	CreatedAt    string  `json:"created_at"`
+	SeatID       *string              `json:"seat_id,omitempty"`
+	PartySize    int                  `json:"party_size"`
+	Companions   []*CompanionResponse `json:"companions"`
+	WaitlistedAt string               `json:"waitlisted_at,omitempty"` // set while status is "waitlisted"
+	CreatedAt    string               `json:"created_at"`
+}
+
+// CompanionResponse is someone a guest brings along, with their seat if they have one.
+type CompanionResponse struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	SeatID *string `json:"seat_id,omitempty"`
+}
+
+// UpdateInviteRequest is the body for the organizer to change an invite's party size.
+type UpdateInviteRequest struct {
+	PartySize int `json:"party_size"`
 }
 
 // InvitationWithEventResponse is returned when a guest lists their invitations (event + invite status).
@@ -71,9 +122,20 @@ type InvitationWithEventResponse struct {
 
 // RespondToInviteRequest is the body for a guest to update their RSVP status.
 type RespondToInviteRequest struct {
-	Status      string  `json:"status"` // "confirmed" or "declined"
-	SeatID      *string `json:"seat_id,omitempty"`      // optional: primary seat when confirming
-	GuestSeatID *string `json:"guest_seat_id,omitempty"` // optional: plus-one seat when bringing a guest
+	Status     string              `json:"status"`               // "confirmed" or "declined"
+	SeatID     *string             `json:"seat_id,omitempty"`    // optional: primary seat when confirming
+	Companions []CompanionRequest  `json:"companions,omitempty"` // optional: replaces the companions; omit to keep them
+	Answers    []RSVPAnswerRequest `json:"answers,omitempty"`    // optional: the guest's own RSVP answers; omit to keep them
+}
+
+// CompanionRequest names a companion the guest brings. ID refers to an existing companion and is empty
+// for a new one. A nil SeatID keeps the companion's seat and an empty one frees it. Answers covers the
+// per-companion RSVP questions; omit it to keep the companion's saved answers.
+type CompanionRequest struct {
+	ID      string              `json:"id,omitempty"`
+	Name    string              `json:"name"`
+	SeatID  *string             `json:"seat_id,omitempty"`
+	Answers []RSVPAnswerRequest `json:"answers,omitempty"`
 }
 
 // PaginatedEventsResponse is used for GET /events with limit/offset.
@@ -98,47 +160,106 @@ type PaginatedInvitesResponse struct {
 type EventTableResponse struct {
 	ID           string               `json:"id"`
 	EventID      string               `json:"event_id"`
+	RoomID       string               `json:"room_id"`
 	Name         string               `json:"name"`
 	Shape        string               `json:"shape"` // "round", "rectangular", or "grid"
 	Rows         *int                 `json:"rows,omitempty"`    // for grid
 	Columns      *int                 `json:"columns,omitempty"` // for grid
 	Capacity     int                  `json:"capacity"`
 	DisplayOrder int                  `json:"display_order"`
+	LabelScheme  string               `json:"seat_label_scheme"`
+	SeatLabels   []string             `json:"seat_labels,omitempty"`
 	PositionX    float64              `json:"position_x"` // 0-100
 	PositionY    float64              `json:"position_y"` // 0-100
+	Width        float64              `json:"width"`      // 0-100
+	Height       float64              `json:"height"`     // 0-100
+	Rotation     float64              `json:"rotation"`   // degrees clockwise
 	Seats        []*EventSeatResponse `json:"seats"`
+
+	// DisplacedInvites lists guests who lost their seat because an update removed it.
+	DisplacedInvites []*EventInviteResponse `json:"displaced_invites,omitempty"`
 }
 
 // EventSeatResponse is a single seat (can show assigned invite).
 type EventSeatResponse struct {
-	ID           string  `json:"id"`
-	EventTableID string  `json:"event_table_id"`
-	Label        string  `json:"label"`
-	DisplayOrder int     `json:"display_order"`
-	InviteID     *string `json:"invite_id,omitempty"` // set if a guest has chosen this seat
+	ID               string  `json:"id"`
+	EventTableID     string  `json:"event_table_id"`
+	Label            string  `json:"label"`
+	DisplayOrder     int     `json:"display_order"`
+	Accessible       bool    `json:"accessible"`
+	VIP              bool    `json:"vip"`
+	Blocked          bool    `json:"blocked"`
+	ReservedInviteID *string `json:"reserved_invite_id,omitempty"` // only this invite may pick the seat
+	Status           string  `json:"status"`                       // "available", "held", "taken" or "blocked"
+	InviteID         *string `json:"invite_id,omitempty"`          // set if a guest has chosen this seat
+	HeldUntil        *string `json:"held_until,omitempty"`         // RFC3339, set while another guest is holding the seat
+}
+
+// UpdateEventSeatRequest changes seat attributes. Nil fields are left unchanged; an empty
+// ReservedInviteID clears the reservation.
+type UpdateEventSeatRequest struct {
+	Accessible       *bool   `json:"accessible,omitempty"`
+	VIP              *bool   `json:"vip,omitempty"`
+	Blocked          *bool   `json:"blocked,omitempty"`
+	ReservedInviteID *string `json:"reserved_invite_id,omitempty"`
+}
+
+// SeatHoldRequest is the body for holding seats while choosing. Minutes defaults to 5 (max 15).
+type SeatHoldRequest struct {
+	SeatIDs []string `json:"seat_ids"`
+	Minutes int      `json:"minutes"`
+}
+
+// SeatHoldResponse describes the caller's active holds for an event.
+type SeatHoldResponse struct {
+	EventID   string   `json:"event_id"`
+	SeatIDs   []string `json:"seat_ids"`
+	ExpiresAt string   `json:"expires_at"`
 }
 
-// CreateEventTableRequest for adding a table to an event. Name is auto-set to "Table N".
+// CreateEventTableRequest for adding a table to an event. Name defaults to "Table N" / "Sitting area N".
 // For shape "grid", Rows and Columns are required and Capacity = Rows * Columns.
+// SeatLabelScheme is "numeric" (default), "row_letter" or "custom"; custom needs one SeatLabels entry per seat.
+// Width and Height default to a size based on the shape; the table is placed on the nearest free spot of
+// its room.
 type CreateEventTableRequest struct {
-	Capacity int    `json:"capacity"`
-	Shape    string `json:"shape"` // "round", "rectangular", or "grid"
-	Rows     *int   `json:"rows,omitempty"`    // required when shape is "grid"
-	Columns  *int   `json:"columns,omitempty"` // required when shape is "grid"
+	Name     string   `json:"name"`
+	Capacity int      `json:"capacity"`
+	Shape    string   `json:"shape"` // "round", "rectangular", or "grid"
+	Rows     *int     `json:"rows,omitempty"`    // required when shape is "grid"
+	Columns  *int     `json:"columns,omitempty"` // required when shape is "grid"
+	Width    *float64 `json:"width,omitempty"`
+	Height   *float64 `json:"height,omitempty"`
+	Rotation float64  `json:"rotation"`
+
+	SeatLabelScheme string   `json:"seat_label_scheme"`
+	SeatLabels      []string `json:"seat_labels,omitempty"`
 }
 
-// UpdateEventTableRequest for updating a table.
+// UpdateEventTableRequest for updating a table. Changing capacity (or rows/columns for a grid) adds or
+// removes seats; set Force to remove seats that guests have already chosen. A new position, size or
+// rotation must keep the table inside the floor and clear of other tables of its room. Changing the seat
+// label scheme relabels the existing seats. RoomID moves the table to another room of the event.
 type UpdateEventTableRequest struct {
+	RoomID    *string  `json:"room_id,omitempty"`
+	Name      *string  `json:"name,omitempty"`
 	Shape     string   `json:"shape"`
 	Rows      *int     `json:"rows,omitempty"`
 	Columns   *int     `json:"columns,omitempty"`
 	Capacity  int      `json:"capacity"`
 	PositionX *float64 `json:"position_x,omitempty"`
 	PositionY *float64 `json:"position_y,omitempty"`
+	Width     *float64 `json:"width,omitempty"`
+	Height    *float64 `json:"height,omitempty"`
+	Rotation  *float64 `json:"rotation,omitempty"`
 	DisplayOrder int   `json:"display_order"`
+	Force     bool     `json:"force"`
+
+	SeatLabelScheme string   `json:"seat_label_scheme,omitempty"` // empty keeps the current scheme
+	SeatLabels      []string `json:"seat_labels,omitempty"`       // custom scheme; nil keeps the current list
 }
 
-// ReorderEventTablesRequest for reordering tables/sitting areas.
+// ReorderEventTablesRequest for reordering tables/sitting areas of an event or of one room.
 type ReorderEventTablesRequest struct {
 	TableIDs []string `json:"table_ids"`
 }
\ No newline at end of file
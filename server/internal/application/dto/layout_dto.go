@@ -0,0 +1,74 @@
+package dto
+
+// SaveLayoutRequest saves an event's current rooms, tables, seats and floor elements as a reusable layout.
+type SaveLayoutRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// UpdateLayoutRequest renames a layout. Nil fields are left unchanged.
+type UpdateLayoutRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ApplyLayoutRequest builds an event's floor from a layout. An event that already has tables is only
+// rebuilt with Replace, and only while none of its seats are assigned.
+type ApplyLayoutRequest struct {
+	Replace bool `json:"replace"`
+}
+
+// VenueLayoutResponse is a saved floor plan. Rooms, Tables and Elements are only filled when a single
+// layout is requested; tables and elements refer to their room by index into Rooms.
+type VenueLayoutResponse struct {
+	ID          string                   `json:"id"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	TableCount  int                      `json:"table_count"`
+	SeatCount   int                      `json:"seat_count"`
+	Rooms       []*LayoutRoomResponse    `json:"rooms,omitempty"`
+	Tables      []*LayoutTableResponse   `json:"tables,omitempty"`
+	Elements    []*LayoutElementResponse `json:"elements,omitempty"`
+	CreatedAt   string                   `json:"created_at"`
+	UpdatedAt   string                   `json:"updated_at"`
+}
+
+type LayoutRoomResponse struct {
+	Name   string  `json:"name"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+}
+
+type LayoutTableResponse struct {
+	Room            int                   `json:"room"`
+	Name            string                `json:"name"`
+	Shape           string                `json:"shape"`
+	Rows            *int                  `json:"rows,omitempty"`
+	Columns         *int                  `json:"columns,omitempty"`
+	Capacity        int                   `json:"capacity"`
+	SeatLabelScheme string                `json:"seat_label_scheme"`
+	PositionX       float64               `json:"position_x"`
+	PositionY       float64               `json:"position_y"`
+	Width           float64               `json:"width"`
+	Height          float64               `json:"height"`
+	Rotation        float64               `json:"rotation"`
+	Seats           []*LayoutSeatResponse `json:"seats"`
+}
+
+type LayoutSeatResponse struct {
+	Label      string `json:"label"`
+	Accessible bool   `json:"accessible"`
+	VIP        bool   `json:"vip"`
+	Blocked    bool   `json:"blocked"`
+}
+
+type LayoutElementResponse struct {
+	Room      int     `json:"room"`
+	Kind      string  `json:"kind"`
+	Label     string  `json:"label"`
+	PositionX float64 `json:"position_x"`
+	PositionY float64 `json:"position_y"`
+	Width     float64 `json:"width"`
+	Height    float64 `json:"height"`
+	Rotation  float64 `json:"rotation"`
+}
@@ -0,0 +1,76 @@
+package dto
+
+// RSVPQuestionRequest creates or updates an RSVP question. Kind is text, single_choice or multi_choice;
+// Options are the choices of the choice kinds. PerCompanion asks each companion too (e.g. a meal
+// choice). DisplayOrder defaults to the end on create and is left unchanged on update when nil.
+type RSVPQuestionRequest struct {
+	Prompt       string   `json:"prompt"`
+	Kind         string   `json:"kind"`
+	Options      []string `json:"options"`
+	Required     bool     `json:"required"`
+	PerCompanion bool     `json:"per_companion"`
+	DisplayOrder *int     `json:"display_order,omitempty"`
+}
+
+// RSVPQuestionResponse is a question of an event's RSVP form.
+type RSVPQuestionResponse struct {
+	ID           string   `json:"id"`
+	EventID      string   `json:"event_id"`
+	Prompt       string   `json:"prompt"`
+	Kind         string   `json:"kind"`
+	Options      []string `json:"options"`
+	Required     bool     `json:"required"`
+	PerCompanion bool     `json:"per_companion"`
+	DisplayOrder int      `json:"display_order"`
+}
+
+// RSVPAnswerRequest answers one question: Text for text questions, Choices for choice questions. An
+// answer with neither counts as unanswered.
+type RSVPAnswerRequest struct {
+	QuestionID string   `json:"question_id"`
+	Text       string   `json:"text,omitempty"`
+	Choices    []string `json:"choices,omitempty"`
+}
+
+// RSVPAnswerResponse is a saved answer; CompanionID is set for a companion's answer.
+type RSVPAnswerResponse struct {
+	QuestionID  string   `json:"question_id"`
+	CompanionID *string  `json:"companion_id,omitempty"`
+	Text        string   `json:"text,omitempty"`
+	Choices     []string `json:"choices,omitempty"`
+}
+
+// RSVPQuestionnaireResponse is an event's RSVP form with the caller's saved answers, if they have any.
+type RSVPQuestionnaireResponse struct {
+	Questions []*RSVPQuestionResponse `json:"questions"`
+	Answers   []*RSVPAnswerResponse   `json:"answers"`
+}
+
+// RSVPSummaryResponse aggregates the answers of confirmed guests and their companions per question.
+type RSVPSummaryResponse struct {
+	Questions []*RSVPQuestionSummary `json:"questions"`
+}
+
+// RSVPQuestionSummary is the tally for one question. Unanswered counts confirmed party members who were
+// asked but gave no answer.
+type RSVPQuestionSummary struct {
+	Question   RSVPQuestionResponse `json:"question"`
+	Answered   int                  `json:"answered"`
+	Unanswered int                  `json:"unanswered"`
+	Options    []*RSVPOptionCount   `json:"options,omitempty"`   // choice questions, in option order
+	Responses  []*RSVPTextResponse  `json:"responses,omitempty"` // text questions
+}
+
+// RSVPOptionCount is how many party members picked an option.
+type RSVPOptionCount struct {
+	Option string `json:"option"`
+	Count  int    `json:"count"`
+}
+
+// RSVPTextResponse is one free-text answer. CompanionName is set when a companion gave it.
+type RSVPTextResponse struct {
+	InviteID      string `json:"invite_id"`
+	Email         string `json:"email"`
+	CompanionName string `json:"companion_name,omitempty"`
+	Text          string `json:"text"`
+}
@@ -0,0 +1,235 @@
+package dto
+
+// AutoSeatRequest asks for an automatic seating plan. By default only confirmed guests without a seat are
+// placed; with ReseatAll every confirmed guest is placed from scratch.
+type AutoSeatRequest struct {
+	ReseatAll bool `json:"reseat_all"`
+}
+
+// SeatAssignmentItem places one invite (and their companions) on seats. Companions left out of
+// CompanionSeats end up without a seat.
+type SeatAssignmentItem struct {
+	InviteID       string              `json:"invite_id"`
+	SeatID         string              `json:"seat_id"`
+	CompanionSeats []CompanionSeatItem `json:"companion_seats,omitempty"`
+}
+
+// CompanionSeatItem places one companion of the invite on a seat.
+type CompanionSeatItem struct {
+	CompanionID string `json:"companion_id"`
+	SeatID      string `json:"seat_id"`
+}
+
+// AutoSeatPlacement is one row of an auto-seat preview.
+type AutoSeatPlacement struct {
+	InviteID  string `json:"invite_id"`
+	Email     string `json:"email"`
+	TableID   string `json:"table_id"`
+	TableName string `json:"table_name"`
+	SeatID    string `json:"seat_id"`
+	SeatLabel string `json:"seat_label"`
+
+	Companions []*AutoSeatCompanionPlacement `json:"companions,omitempty"`
+}
+
+// AutoSeatCompanionPlacement is the seat proposed for one companion of a placed guest.
+type AutoSeatCompanionPlacement struct {
+	CompanionID string `json:"companion_id"`
+	Name        string `json:"name"`
+	SeatID      string `json:"seat_id"`
+	SeatLabel   string `json:"seat_label"`
+}
+
+// AutoSeatUnplaced is a confirmed guest the solver could not fit.
+type AutoSeatUnplaced struct {
+	InviteID  string `json:"invite_id"`
+	Email     string `json:"email"`
+	PartySize int    `json:"party_size"`
+}
+
+// AutoSeatPreviewResponse is a proposed seating plan. Nothing is saved until the assignments are sent
+// to the apply endpoint.
+type AutoSeatPreviewResponse struct {
+	Placements []*AutoSeatPlacement `json:"placements"`
+	Unplaced   []*AutoSeatUnplaced  `json:"unplaced"`
+}
+
+// ApplySeatingRequest applies a seating plan (usually an auto-seat preview) in one transaction.
+type ApplySeatingRequest struct {
+	Assignments []SeatAssignmentItem `json:"assignments"`
+}
+
+// SeatingConstraintRequest creates or updates a rule between two invites. Kind is "together" or "apart".
+type SeatingConstraintRequest struct {
+	Kind           string `json:"kind"`
+	FirstInviteID  string `json:"first_invite_id"`
+	SecondInviteID string `json:"second_invite_id"`
+	Note           string `json:"note"`
+}
+
+// SeatingConstraintResponse is a rule between two invites of an event.
+type SeatingConstraintResponse struct {
+	ID             string `json:"id"`
+	EventID        string `json:"event_id"`
+	Kind           string `json:"kind"`
+	FirstInviteID  string `json:"first_invite_id"`
+	SecondInviteID string `json:"second_invite_id"`
+	Note           string `json:"note"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+}
+
+// SeatingConstraintViolation explains why a rule is broken by the current seating.
+// Reason is "unseated" (a "together" guest has no seat), "different_tables" or "same_table".
+type SeatingConstraintViolation struct {
+	Constraint  SeatingConstraintResponse `json:"constraint"`
+	Reason      string                    `json:"reason"`
+	FirstEmail  string                    `json:"first_email"`
+	SecondEmail string                    `json:"second_email"`
+	TableIDs    []string                  `json:"table_ids,omitempty"` // tables involved in the violation
+}
+
+// SeatingConstraintReportResponse checks every rule of an event against the current seating.
+type SeatingConstraintReportResponse struct {
+	Checked    int                           `json:"checked"`
+	Violations []*SeatingConstraintViolation `json:"violations"`
+}
+
+// AssignSeatRequest seats an invite, or one of their companions when CompanionID is set, in SeatID.
+type AssignSeatRequest struct {
+	InviteID    string `json:"invite_id"`
+	SeatID      string `json:"seat_id"`
+	CompanionID string `json:"companion_id,omitempty"`
+}
+
+// UnassignSeatRequest frees a seat.
+type UnassignSeatRequest struct {
+	SeatID string `json:"seat_id"`
+}
+
+// MoveSeatRequest moves whoever sits in FromSeatID to the free seat ToSeatID.
+type MoveSeatRequest struct {
+	FromSeatID string `json:"from_seat_id"`
+	ToSeatID   string `json:"to_seat_id"`
+}
+
+// SwapSeatsRequest exchanges the occupants of two seats. Either seat may be empty, but not both.
+type SwapSeatsRequest struct {
+	FirstSeatID  string `json:"first_seat_id"`
+	SecondSeatID string `json:"second_seat_id"`
+}
+
+// EventSeatingResponse is the floor plan of an event or of one of its rooms: the rooms, tables with
+// their seats and the non-seating elements.
+type EventSeatingResponse struct {
+	Rooms    []*EventRoomResponse    `json:"rooms"`
+	Tables   []*EventTableResponse   `json:"tables"`
+	Elements []*FloorElementResponse `json:"elements"`
+}
+
+// EventRoomRequest creates or updates a room. Width and Height give the proportions of the room's floor
+// and default to 30 x 20 on create; nil fields are left unchanged on update. DisplayOrder defaults to the
+// end.
+type EventRoomRequest struct {
+	Name         string   `json:"name"`
+	Width        *float64 `json:"width,omitempty"`
+	Height       *float64 `json:"height,omitempty"`
+	DisplayOrder *int     `json:"display_order,omitempty"`
+}
+
+// EventRoomResponse is a room or floor of an event venue.
+type EventRoomResponse struct {
+	ID           string  `json:"id"`
+	EventID      string  `json:"event_id"`
+	Name         string  `json:"name"`
+	Width        float64 `json:"width"`  // relative to Height
+	Height       float64 `json:"height"` // relative to Width
+	DisplayOrder int     `json:"display_order"`
+	TableCount   int     `json:"table_count"`
+}
+
+// FloorElementRequest creates or updates a floor element. Kind is one of stage, dance_floor, bar,
+// entrance, buffet or restroom. Position, width and height are percentages of the room floor (0-100);
+// Label defaults to a name derived from Kind. RoomID defaults to the event's first room on create and
+// to the element's current room on update.
+type FloorElementRequest struct {
+	RoomID    string  `json:"room_id,omitempty"`
+	Kind      string  `json:"kind"`
+	Label     string  `json:"label"`
+	PositionX float64 `json:"position_x"`
+	PositionY float64 `json:"position_y"`
+	Width     float64 `json:"width"`
+	Height    float64 `json:"height"`
+	Rotation  float64 `json:"rotation"`
+}
+
+// FloorElementResponse is a stage, bar, entrance or similar object on the floor plan.
+type FloorElementResponse struct {
+	ID        string  `json:"id"`
+	EventID   string  `json:"event_id"`
+	RoomID    string  `json:"room_id"`
+	Kind      string  `json:"kind"`
+	Label     string  `json:"label"`
+	PositionX float64 `json:"position_x"` // 0-100, center
+	PositionY float64 `json:"position_y"` // 0-100, center
+	Width     float64 `json:"width"`      // 0-100
+	Height    float64 `json:"height"`     // 0-100
+	Rotation  float64 `json:"rotation"`   // degrees clockwise
+}
+
+// SeatingSnapshotResponse summarizes one version of an event's seating.
+type SeatingSnapshotResponse struct {
+	ID            string  `json:"id"`
+	EventID       string  `json:"event_id"`
+	Reason        string  `json:"reason"`
+	CreatedBy     *string `json:"created_by,omitempty"`
+	RoomCount     int     `json:"room_count"`
+	TableCount    int     `json:"table_count"`
+	SeatCount     int     `json:"seat_count"`
+	AssignedCount int     `json:"assigned_count"`
+	CreatedAt     string  `json:"created_at"`
+}
+
+// PaginatedSeatingSnapshotsResponse lists snapshots newest first.
+type PaginatedSeatingSnapshotsResponse struct {
+	Items []*SeatingSnapshotResponse `json:"items"`
+	Total int64                      `json:"total"`
+}
+
+// SeatingDiffResponse lists what changed from one snapshot to another.
+type SeatingDiffResponse struct {
+	From        *SeatingSnapshotResponse   `json:"from"`
+	To          *SeatingSnapshotResponse   `json:"to"`
+	Changes     []*SeatingChange           `json:"changes"`
+	Assignments []*SeatingAssignmentChange `json:"assignments"`
+}
+
+// SeatingChange is a room, table, seat or floor element that was added, removed or changed.
+type SeatingChange struct {
+	Kind   string   `json:"kind"` // "room", "table", "seat" or "element"
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`             // room or table name, "Table / seat" label, element label
+	Change string   `json:"change"`           // "added", "removed" or "changed"
+	Fields []string `json:"fields,omitempty"` // for "changed": the fields that differ
+}
+
+// SeatingAssignmentChange is a guest or companion whose seat differs between two snapshots.
+type SeatingAssignmentChange struct {
+	InviteID    string  `json:"invite_id"`
+	Email       string  `json:"email,omitempty"`
+	CompanionID *string `json:"companion_id,omitempty"` // set when the seat is a companion's
+	FromSeatID  *string `json:"from_seat_id,omitempty"`
+	FromSeat    string  `json:"from_seat,omitempty"`
+	ToSeatID    *string `json:"to_seat_id,omitempty"`
+	ToSeat      string  `json:"to_seat,omitempty"`
+}
+
+// RestoreSeatingSnapshotResponse is the seating after a restore. SkippedInviteIDs lists guests who had a
+// seat in the snapshot but have since declined or been removed, so they were not seated again.
+// UnseatedInviteIDs lists guests who had a seat before the restore and have none after it.
+type RestoreSeatingSnapshotResponse struct {
+	Snapshot          *SeatingSnapshotResponse `json:"snapshot"`
+	Seating           *EventSeatingResponse    `json:"seating"`
+	SkippedInviteIDs  []string                 `json:"skipped_invite_ids,omitempty"`
+	UnseatedInviteIDs []string                 `json:"unseated_invite_ids,omitempty"`
+}
@@ -0,0 +1,180 @@
+package usecases
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	"github.com/KalebAsratemedhin/seatmaster/pkg/ical"
+)
+
+const (
+	calendarProdID = "-//Seatmaster//Events//EN"
+	// calendarRefresh is how often subscribed calendar apps are asked to fetch the feed again.
+	calendarRefresh = time.Hour
+)
+
+// CalendarUseCase exports events as iCalendar files: one event at a time, or every event a user owns
+// or is going to through a private subscription feed. Both are built from the stored event on each
+// request, so an edited time shows up the next time a calendar app refreshes.
+type CalendarUseCase struct {
+	eventRepo       repositories.EventRepository
+	eventInviteRepo repositories.EventInviteRepository
+	userRepo        repositories.UserRepository
+	baseURL         string // public URL of this API, for feed links
+	appURL          string // public URL of the web app, for event links; may be empty
+}
+
+func NewCalendarUseCase(
+	eventRepo repositories.EventRepository,
+	eventInviteRepo repositories.EventInviteRepository,
+	userRepo repositories.UserRepository,
+	baseURL string,
+	appURL string,
+) *CalendarUseCase {
+	return &CalendarUseCase{
+		eventRepo:       eventRepo,
+		eventInviteRepo: eventInviteRepo,
+		userRepo:        userRepo,
+		baseURL:         strings.TrimSuffix(baseURL, "/"),
+		appURL:          strings.TrimSuffix(appURL, "/"),
+	}
+}
+
+// EventCalendar returns the event as an .ics file, to anyone who may view the event.
+func (uc *CalendarUseCase) EventCalendar(ctx context.Context, eventID, callerID string) (*entities.Event, []byte, error) {
+	event, err := uc.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if event.Visibility == entities.VisibilityPrivate {
+		if callerID == "" {
+			return nil, nil, errors.New("forbidden: event is private")
+		}
+		if event.OwnerID != callerID {
+			invited, err := uc.eventInviteRepo.ExistsByEventAndUser(ctx, eventID, callerID)
+			if err != nil || !invited {
+				return nil, nil, errors.New("forbidden: you do not have access to this event")
+			}
+		}
+	}
+	cal := &ical.Calendar{ProdID: calendarProdID, Events: []ical.Event{uc.calendarEvent(event)}}
+	return event, cal.Encode(), nil
+}
+
+// GetFeed returns the user's calendar subscription URL, creating its token on first use.
+func (uc *CalendarUseCase) GetFeed(ctx context.Context, userID string) (*dto.CalendarFeedResponse, error) {
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.CalendarToken != nil && *user.CalendarToken != "" {
+		return uc.feedResponse(*user.CalendarToken), nil
+	}
+	return uc.ResetFeed(ctx, userID)
+}
+
+// ResetFeed replaces the user's calendar token, so the old subscription URL stops working.
+func (uc *CalendarUseCase) ResetFeed(ctx context.Context, userID string) (*dto.CalendarFeedResponse, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	token := hex.EncodeToString(b)
+	if err := uc.userRepo.SetCalendarToken(ctx, userID, token); err != nil {
+		return nil, err
+	}
+	return uc.feedResponse(token), nil
+}
+
+// Feed returns the calendar behind a subscription token: the events its user owns and those they
+// have confirmed. The token is the only credential, as calendar apps cannot log in.
+func (uc *CalendarUseCase) Feed(ctx context.Context, token string) ([]byte, error) {
+	if token == "" {
+		return nil, errors.New("calendar feed not found")
+	}
+	user, err := uc.userRepo.FindByCalendarToken(ctx, token)
+	if err != nil {
+		return nil, errors.New("calendar feed not found")
+	}
+	events, err := uc.eventRepo.FindByOwnerID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(events))
+	for _, e := range events {
+		seen[e.ID] = true
+	}
+	invites, err := uc.eventInviteRepo.ListByUserIDOrEmail(ctx, user.ID, user.Email)
+	if err != nil {
+		return nil, err
+	}
+	for _, inv := range invites {
+		if inv.Status != "confirmed" || seen[inv.EventID] {
+			continue
+		}
+		event, err := uc.eventRepo.FindByID(ctx, inv.EventID)
+		if err != nil {
+			continue
+		}
+		seen[event.ID] = true
+		events = append(events, event)
+	}
+
+	cal := &ical.Calendar{
+		ProdID:          calendarProdID,
+		Name:            "Seatmaster",
+		RefreshInterval: calendarRefresh,
+		Events:          make([]ical.Event, 0, len(events)),
+	}
+	for _, e := range events {
+		cal.Events = append(cal.Events, uc.calendarEvent(e))
+	}
+	return cal.Encode(), nil
+}
+
+func (uc *CalendarUseCase) feedResponse(token string) *dto.CalendarFeedResponse {
+	url := uc.baseURL + "/api/v1/calendar/" + token + ".ics"
+	webcal := url
+	if i := strings.Index(url, "://"); i >= 0 {
+		webcal = "webcal" + url[i:]
+	}
+	return &dto.CalendarFeedResponse{URL: url, WebcalURL: webcal}
+}
+
+// calendarEvent is the event as it appears in downloads and feeds, linked to its page in the web app.
+func (uc *CalendarUseCase) calendarEvent(event *entities.Event) ical.Event {
+	e := newCalendarEvent(event)
+	if uc.appURL != "" {
+		e.URL = uc.appURL + "/events/" + event.ID
+	}
+	return e
+}
+
+// newCalendarEvent maps an event to a VEVENT. The UID stays the same across edits, and SEQUENCE and
+// LAST-MODIFIED move forward with them, so calendar apps replace their copy instead of adding another.
+func newCalendarEvent(event *entities.Event) ical.Event {
+	start, end := event.Schedule()
+	e := ical.Event{
+		UID:          event.ID + "@seatmaster",
+		Sequence:     event.CalendarSequence,
+		Stamp:        event.UpdatedAt,
+		LastModified: event.UpdatedAt,
+		Start:        start,
+		End:          end,
+		Summary:      event.Name,
+		Description:  event.Message,
+		Location:     event.Location,
+		Status:       "CONFIRMED",
+	}
+	if event.Latitude != 0 || event.Longitude != 0 {
+		e.Geo = &[2]float64{event.Latitude, event.Longitude}
+	}
+	return e
+}
@@ -43,17 +43,16 @@ func (uc *DashboardUseCase) GetDashboard(ctx context.Context, ownerID string) (*
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now().UTC()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	// Events count as active until they end, in their own time zone.
+	now := time.Now()
 	var activeEvents int64
 	for _, e := range events {
-		ed := time.Date(e.EventDate.Year(), e.EventDate.Month(), e.EventDate.Day(), 0, 0, 0, 0, time.UTC)
-		if !ed.Before(today) {
+		if !e.HasEnded(now) {
 			activeEvents++
 		}
 	}
 
-	totalInvited, confirmed, declined, pending, err := uc.eventInviteRepo.CountByOwnerIDGroupByStatus(ctx, ownerID)
+	totalInvited, confirmed, declined, pending, waitlisted, err := uc.eventInviteRepo.CountByOwnerIDGroupByStatus(ctx, ownerID)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +80,8 @@ func (uc *DashboardUseCase) GetDashboard(ctx context.Context, ownerID string) (*
 			guestName = strings.Split(inv.Email, "@")[0]
 		}
 		plusOne := "No"
-		if inv.GuestSeatID != nil && *inv.GuestSeatID != "" {
-			plusOne = "Yes (1)"
+		if len(inv.Companions) > 0 {
+			plusOne = fmt.Sprintf("Yes (%d)", len(inv.Companions))
 		} else if inv.Status != "confirmed" && inv.Status != "declined" {
 			plusOne = "-"
 		}
@@ -98,13 +97,12 @@ func (uc *DashboardUseCase) GetDashboard(ctx context.Context, ownerID string) (*
 	}
 
 	var upcomingEvent *dto.DashboardEventSummary
-	var nextDate *time.Time
+	var nextStart *time.Time
 	for _, e := range events {
-		ed := time.Date(e.EventDate.Year(), e.EventDate.Month(), e.EventDate.Day(), 0, 0, 0, 0, time.UTC)
-		if !ed.Before(today) {
-			if nextDate == nil || e.EventDate.Before(*nextDate) {
-				t := e.EventDate
-				nextDate = &t
+		if !e.HasEnded(now) {
+			start, _ := e.Schedule()
+			if nextStart == nil || start.Before(*nextStart) {
+				nextStart = &start
 				upcomingEvent = &dto.DashboardEventSummary{
 					ID:        e.ID,
 					Name:      e.Name,
@@ -119,7 +117,7 @@ func (uc *DashboardUseCase) GetDashboard(ctx context.Context, ownerID string) (*
 	var myRecentRSVPs []*dto.MyRecentRSVPItem
 	myInvites, err := uc.eventInviteRepo.ListByUserIDOrEmail(ctx, ownerID, userEmail)
 	if err == nil && len(myInvites) > 0 {
-		var gTotal, gConfirmed, gDeclined, gPending int64
+		var gTotal, gConfirmed, gDeclined, gPending, gWaitlisted int64
 		for _, inv := range myInvites {
 			gTotal++
 			switch inv.Status {
@@ -127,6 +125,8 @@ func (uc *DashboardUseCase) GetDashboard(ctx context.Context, ownerID string) (*
 				gConfirmed++
 			case "declined":
 				gDeclined++
+			case "waitlisted":
+				gWaitlisted++
 			default:
 				gPending++
 			}
@@ -136,6 +136,7 @@ func (uc *DashboardUseCase) GetDashboard(ctx context.Context, ownerID string) (*
 			Confirmed: gConfirmed,
 			Pending:   gPending,
 			Declined:  gDeclined,
+			Waitlisted: gWaitlisted,
 		}
 		// Sort by UpdatedAt desc and take 10 for "events I recently RSVP'd to"
 		sortInvitesByUpdatedAtDesc(myInvites)
@@ -168,6 +169,7 @@ func (uc *DashboardUseCase) GetDashboard(ctx context.Context, ownerID string) (*
 		Confirmed:      confirmed,
 		Pending:        pending,
 		Declined:       declined,
+		Waitlisted:     waitlisted,
 		RecentRSVPs:    recentRSVPs,
 		UpcomingEvent:  upcomingEvent,
 		GuestStats:     guestStats,
@@ -0,0 +1,56 @@
+package usecases
+
+import (
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// DisplacedGuestsError is returned when a change would remove seats that guests have chosen.
+// It wraps errors.ErrSeatsAssigned and carries the affected invites.
+type DisplacedGuestsError struct {
+	Invites []*dto.EventInviteResponse
+}
+
+func (e *DisplacedGuestsError) Error() string { return apperrors.ErrSeatsAssigned.Error() }
+
+func (e *DisplacedGuestsError) Unwrap() error { return apperrors.ErrSeatsAssigned }
+
+// ErrorDetails is included in the HTTP error response.
+func (e *DisplacedGuestsError) ErrorDetails() interface{} {
+	return map[string]interface{}{"invites": e.Invites}
+}
+
+// TablePlacementError is returned when a table would leave the floor or overlap another table. It wraps
+// errors.ErrTableOutOfBounds or errors.ErrTableOverlap and suggests the nearest free position, if any.
+type TablePlacementError struct {
+	Err        error
+	SuggestedX *float64
+	SuggestedY *float64
+}
+
+func (e *TablePlacementError) Error() string { return e.Err.Error() }
+
+func (e *TablePlacementError) Unwrap() error { return e.Err }
+
+// ErrorDetails is included in the HTTP error response.
+func (e *TablePlacementError) ErrorDetails() interface{} {
+	return map[string]interface{}{"suggested_position_x": e.SuggestedX, "suggested_position_y": e.SuggestedY}
+}
+
+// AlreadyCheckedInError is returned when a scan checks in nobody new because the party already arrived.
+// It wraps errors.ErrAlreadyCheckedIn and carries the time of the earlier check-in.
+type AlreadyCheckedInError struct {
+	CheckedInAt string
+	Party       *dto.CheckInPartyResponse
+}
+
+func (e *AlreadyCheckedInError) Error() string {
+	return apperrors.ErrAlreadyCheckedIn.Error() + " at " + e.CheckedInAt
+}
+
+func (e *AlreadyCheckedInError) Unwrap() error { return apperrors.ErrAlreadyCheckedIn }
+
+// ErrorDetails is included in the HTTP error response.
+func (e *AlreadyCheckedInError) ErrorDetails() interface{} {
+	return map[string]interface{}{"checked_in_at": e.CheckedInAt, "party": e.Party}
+}
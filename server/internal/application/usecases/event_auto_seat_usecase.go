@@ -0,0 +1,199 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// PreviewAutoSeating proposes seats for confirmed guests. Owner only. A guest and their companions always
+// end up at the same table. Seats that are taken by guests who are not being placed, held by someone on
+// the RSVP page, blocked or reserved are left alone. Nothing is saved; send the placements to
+// ApplySeatingPlan to keep them.
+func (uc *EventUseCase) PreviewAutoSeating(ctx context.Context, ownerID, eventID string, req dto.AutoSeatRequest) (*dto.AutoSeatPreviewResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	tables, err := uc.eventTableRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	seats, err := uc.eventSeatRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	invites, err := uc.eventInviteRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	// Oldest confirmations first, so earlier guests win when seats run out.
+	sort.SliceStable(invites, func(i, j int) bool { return invites[i].CreatedAt.Before(invites[j].CreatedAt) })
+	seatToInvite := uc.seatToInviteMap(ctx, eventID)
+	seatHolds := uc.seatHoldMap(ctx, eventID)
+
+	inviteByID := make(map[string]*entities.EventInvite)
+	var parties []solverParty
+	for _, inv := range invites {
+		if inv.Status != "confirmed" || (!req.ReseatAll && inv.SeatID != nil) {
+			continue
+		}
+		inviteByID[inv.ID] = inv
+		parties = append(parties, solverParty{InviteID: inv.ID, Size: inviteHeads(inv)})
+	}
+
+	seatByID := make(map[string]*entities.EventSeat, len(seats))
+	freeByTable := make(map[string][]string)
+	for _, seat := range seats {
+		seatByID[seat.ID] = seat
+		if occupant, ok := seatToInvite[seat.ID]; ok && inviteByID[occupant] == nil {
+			continue
+		}
+		if _, held := seatHolds[seat.ID]; held {
+			continue
+		}
+		if seat.Blocked || seat.ReservedInviteID != nil {
+			continue
+		}
+		freeByTable[seat.EventTableID] = append(freeByTable[seat.EventTableID], seat.ID)
+	}
+	tableByID := make(map[string]*entities.EventTable, len(tables))
+	solverTables := make([]solverTable, 0, len(tables))
+	for _, t := range tables {
+		tableByID[t.ID] = t
+		solverTables = append(solverTables, solverTable{ID: t.ID, FreeSeats: freeByTable[t.ID]})
+	}
+
+	placed, unplaced := solveSeating(solverTables, parties)
+	resp := &dto.AutoSeatPreviewResponse{
+		Placements: make([]*dto.AutoSeatPlacement, 0, len(placed)),
+		Unplaced:   make([]*dto.AutoSeatUnplaced, 0, len(unplaced)),
+	}
+	for _, p := range placed {
+		item := &dto.AutoSeatPlacement{
+			InviteID:  p.InviteID,
+			Email:     inviteByID[p.InviteID].Email,
+			TableID:   p.TableID,
+			TableName: tableByID[p.TableID].Name,
+			SeatID:    p.SeatIDs[0],
+			SeatLabel: seatByID[p.SeatIDs[0]].Label,
+		}
+		for i, c := range inviteByID[p.InviteID].Companions {
+			seatID := p.SeatIDs[i+1]
+			item.Companions = append(item.Companions, &dto.AutoSeatCompanionPlacement{
+				CompanionID: c.ID,
+				Name:        c.Name,
+				SeatID:      seatID,
+				SeatLabel:   seatByID[seatID].Label,
+			})
+		}
+		resp.Placements = append(resp.Placements, item)
+	}
+	for _, p := range unplaced {
+		resp.Unplaced = append(resp.Unplaced, &dto.AutoSeatUnplaced{
+			InviteID:  p.InviteID,
+			Email:     inviteByID[p.InviteID].Email,
+			PartySize: p.Size,
+		})
+	}
+	return resp, nil
+}
+
+// ApplySeatingPlan seats confirmed guests as given, all or nothing. Owner only. Guests in the plan give up
+// their current seats first, so a plan may move people around; a seat held by a guest outside the plan
+// fails the whole plan with errors.ErrSeatTaken. Companions must sit at the same table as their guest.
+func (uc *EventUseCase) ApplySeatingPlan(ctx context.Context, ownerID, eventID string, req dto.ApplySeatingRequest) ([]*dto.EventInviteResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	if len(req.Assignments) == 0 {
+		return nil, errors.New("assignments is required")
+	}
+	seats, err := uc.eventSeatRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	seatByID := make(map[string]*entities.EventSeat, len(seats))
+	for _, seat := range seats {
+		seatByID[seat.ID] = seat
+	}
+	usedSeats := make(map[string]bool)
+	useSeat := func(seatID string) (*entities.EventSeat, error) {
+		seat, ok := seatByID[seatID]
+		if !ok {
+			return nil, errors.New("seat does not belong to this event")
+		}
+		if usedSeats[seatID] {
+			return nil, errors.New("a seat appears more than once in the plan")
+		}
+		if seat.Blocked {
+			return nil, apperrors.ErrSeatBlocked
+		}
+		usedSeats[seatID] = true
+		return seat, nil
+	}
+	invites := make([]*entities.EventInvite, 0, len(req.Assignments))
+	seenInvites := make(map[string]bool)
+	var lockIDs []string
+	for _, a := range req.Assignments {
+		if seenInvites[a.InviteID] {
+			return nil, errors.New("an invite appears more than once in the plan")
+		}
+		seenInvites[a.InviteID] = true
+		invite, err := uc.eventInviteRepo.FindByID(ctx, a.InviteID)
+		if err != nil || invite.EventID != eventID {
+			return nil, errors.New("invite not found")
+		}
+		if invite.Status != "confirmed" {
+			return nil, errors.New("only confirmed guests can be seated")
+		}
+		seat, err := useSeat(a.SeatID)
+		if err != nil {
+			return nil, err
+		}
+		clearPartySeats(invite)
+		seatID := a.SeatID
+		invite.SeatID = &seatID
+		lockIDs = append(lockIDs, seatID)
+		for _, cs := range a.CompanionSeats {
+			companion, err := findCompanion(invite, cs.CompanionID)
+			if err != nil {
+				return nil, err
+			}
+			if companion.SeatID != nil {
+				return nil, errors.New("a companion appears more than once in the plan")
+			}
+			companionSeat, err := useSeat(cs.SeatID)
+			if err != nil {
+				return nil, err
+			}
+			if companionSeat.EventTableID != seat.EventTableID {
+				return nil, errors.New("companions must sit at the same table as their guest")
+			}
+			companionSeatID := cs.SeatID
+			companion.SeatID = &companionSeatID
+			lockIDs = append(lockIDs, companionSeatID)
+		}
+		invites = append(invites, invite)
+	}
+	var before map[string]string
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotPlanApplied, func(ctx context.Context) error {
+		before = uc.seatToInviteMap(ctx, eventID)
+		if err := uc.eventSeatRepo.LockByIDs(ctx, lockIDs); err != nil {
+			return err
+		}
+		return uc.reseatInvites(ctx, invites)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.notifySeatChanges(ctx, eventID, before)
+	out := make([]*dto.EventInviteResponse, len(invites))
+	for i, invite := range invites {
+		out[i] = uc.toEventInviteResponse(invite)
+	}
+	return out, nil
+}
@@ -0,0 +1,94 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+	"github.com/KalebAsratemedhin/seatmaster/pkg/ical"
+)
+
+// calendarMessage builds the iTIP message (REQUEST or CANCEL) emailed to one guest. Each guest is the
+// only attendee in their copy, so guests never see each other's addresses. Replies are not requested:
+// guests answer through the RSVP page.
+func calendarMessage(event *entities.Event, organizer *ical.Person, method string, invite *entities.EventInvite) *services.CalendarPart {
+	e := newCalendarEvent(event)
+	e.Stamp = time.Now()
+	e.Organizer = organizer
+	partStat := "NEEDS-ACTION"
+	switch invite.Status {
+	case "confirmed":
+		partStat = "ACCEPTED"
+	case "declined":
+		partStat = "DECLINED"
+	}
+	e.Attendees = []ical.Attendee{{Person: ical.Person{Email: invite.Email}, PartStat: partStat}}
+	if method == "CANCEL" {
+		e.Status = "CANCELLED"
+	}
+	cal := &ical.Calendar{ProdID: calendarProdID, Method: method, Events: []ical.Event{e}}
+	return &services.CalendarPart{Method: method, Data: cal.Encode()}
+}
+
+// calendarOrganizer returns the event owner as the organizer of its calendar messages, or nil when the
+// owner can't be loaded.
+func (uc *EventUseCase) calendarOrganizer(ctx context.Context, event *entities.Event) *ical.Person {
+	owner, err := uc.userRepo.FindByID(ctx, event.OwnerID)
+	if err != nil {
+		return nil
+	}
+	return &ical.Person{Name: strings.TrimSpace(owner.FirstName + " " + owner.LastName), Email: owner.Email}
+}
+
+// hasCalendarCopy reports whether the guest may have the event in their calendar: they were emailed a
+// calendar invitation, or confirmed and could have added it themselves, and have not declined since.
+func hasCalendarCopy(invite *entities.EventInvite) bool {
+	if invite.Status == "declined" {
+		return false
+	}
+	return invite.CalendarInvited || invite.Status == "confirmed"
+}
+
+// notifyRescheduled sends a calendar update to every guest who has the event in their calendar, pending
+// and waitlisted guests included, after its date, time or place changed. The caller has already raised
+// the event's CalendarSequence.
+func (uc *EventUseCase) notifyRescheduled(ctx context.Context, event *entities.Event) {
+	invites, err := uc.eventInviteRepo.ListByEventID(ctx, event.ID)
+	if err != nil {
+		return
+	}
+	organizer := uc.calendarOrganizer(ctx, event)
+	eventPath := fmt.Sprintf("/events/%s", event.ID)
+	for _, inv := range invites {
+		if !hasCalendarCopy(inv) {
+			continue
+		}
+		_ = uc.mailer.SendEventUpdatedEmail(ctx, inv.Email, event.Name, eventPath, calendarMessage(event, organizer, "REQUEST", inv))
+	}
+}
+
+// notifyCancelled sends a calendar cancellation for the deleted event to every guest among invites who
+// has it in their calendar.
+func (uc *EventUseCase) notifyCancelled(ctx context.Context, event *entities.Event, invites []*entities.EventInvite) {
+	organizer := uc.calendarOrganizer(ctx, event)
+	event.CalendarSequence++
+	for _, inv := range invites {
+		if !hasCalendarCopy(inv) {
+			continue
+		}
+		_ = uc.mailer.SendEventCancelledEmail(ctx, inv.Email, event.Name, calendarMessage(event, organizer, "CANCEL", inv))
+	}
+}
+
+// notifyDeclined sends a guest who just declined a cancellation of their own copy of the event, when
+// they had one before (previous is their status before the decline). The event itself goes on, so its
+// CalendarSequence stays.
+func (uc *EventUseCase) notifyDeclined(ctx context.Context, event *entities.Event, invite *entities.EventInvite, previous string) {
+	if !invite.CalendarInvited && previous != "confirmed" {
+		return
+	}
+	_ = uc.mailer.SendRSVPDeclinedEmail(ctx, invite.Email, event.Name, calendarMessage(event, uc.calendarOrganizer(ctx, event), "CANCEL", invite))
+}
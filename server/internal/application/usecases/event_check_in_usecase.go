@@ -0,0 +1,310 @@
+package usecases
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// CheckInGuest marks the members of a party who arrived: the guest holding the ticket unless
+// IncludeGuest is false, and the companions listed (all of them by default), so companions can arrive
+// before or after the guest. The party is found by its signed ticket token or, when picked from the
+// door list, by invite ID. Members who already checked in are skipped; a scan that checks in nobody new
+// fails with an AlreadyCheckedInError carrying the earliest check-in among them. Owner only.
+func (uc *EventUseCase) CheckInGuest(ctx context.Context, ownerID, eventID string, req dto.CheckInRequest) (*dto.CheckInPartyResponse, error) {
+	_, err := uc.findOwnedEvent(ctx, ownerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	var invite *entities.EventInvite
+	if req.InviteID != "" {
+		invite, err = uc.findConfirmedInvite(ctx, eventID, req.InviteID)
+	} else {
+		invite, err = uc.findTicketInvite(ctx, eventID, req.Token)
+	}
+	if err != nil {
+		return nil, err
+	}
+	companions := invite.Companions
+	if req.CompanionIDs != nil {
+		companions = make([]*entities.EventInviteCompanion, 0, len(req.CompanionIDs))
+		for _, id := range req.CompanionIDs {
+			c, err := findCompanion(invite, id)
+			if err != nil {
+				return nil, err
+			}
+			companions = append(companions, c)
+		}
+	}
+
+	var members []*string // nil for the guest
+	if req.IncludeGuest == nil || *req.IncludeGuest {
+		members = append(members, nil)
+	}
+	for _, c := range companions {
+		companionID := c.ID
+		members = append(members, &companionID)
+	}
+	if len(members) == 0 {
+		return nil, errors.New("select at least one member of the party to check in")
+	}
+
+	now := time.Now().UTC()
+	added := false
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, companionID := range members {
+			ok, err := uc.eventCheckInRepo.Create(ctx, &entities.EventCheckIn{
+				EventID:     eventID,
+				InviteID:    invite.ID,
+				CompanionID: companionID,
+				CheckedInBy: &ownerID,
+				CheckedInAt: now,
+			})
+			if err != nil {
+				return err
+			}
+			added = added || ok
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	checkIns, err := uc.eventCheckInRepo.ListByInviteID(ctx, invite.ID)
+	if err != nil {
+		return nil, err
+	}
+	party := uc.toCheckInPartyResponse(ctx, invite, checkIns)
+	if !added {
+		return nil, &AlreadyCheckedInError{CheckedInAt: earliestCheckIn(checkIns, members), Party: party}
+	}
+	return party, nil
+}
+
+// earliestCheckIn returns when the first of the given members (nil for the guest) checked in.
+func earliestCheckIn(checkIns []*entities.EventCheckIn, members []*string) string {
+	var first time.Time
+	for _, c := range checkIns {
+		for _, m := range members {
+			if stringPtrValue(m) == stringPtrValue(c.CompanionID) && (first.IsZero() || c.CheckedInAt.Before(first)) {
+				first = c.CheckedInAt
+			}
+		}
+	}
+	if first.IsZero() {
+		return ""
+	}
+	return first.UTC().Format(time.RFC3339)
+}
+
+// UndoCheckIn removes a check-in made by mistake: the companion's when companionID is set, otherwise
+// those of the whole party. Owner only.
+func (uc *EventUseCase) UndoCheckIn(ctx context.Context, ownerID, eventID, inviteID, companionID string) (*dto.CheckInPartyResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	invite, err := uc.eventInviteRepo.FindByID(ctx, inviteID)
+	if err != nil || invite.EventID != eventID {
+		return nil, errors.New("invite not found")
+	}
+	if companionID != "" {
+		if _, err := findCompanion(invite, companionID); err != nil {
+			return nil, err
+		}
+	}
+	checkIns, err := uc.eventCheckInRepo.ListByInviteID(ctx, invite.ID)
+	if err != nil {
+		return nil, err
+	}
+	var undo []*entities.EventCheckIn
+	for _, c := range checkIns {
+		if companionID == "" || (c.CompanionID != nil && *c.CompanionID == companionID) {
+			undo = append(undo, c)
+		}
+	}
+	if len(undo) == 0 {
+		return nil, apperrors.ErrNotCheckedIn
+	}
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, c := range undo {
+			if err := uc.eventCheckInRepo.Delete(ctx, c); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	checkIns, err = uc.eventCheckInRepo.ListByInviteID(ctx, invite.ID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.toCheckInPartyResponse(ctx, invite, checkIns), nil
+}
+
+// ListCheckIns returns the event's door list: every confirmed party with its arrivals, and the counts
+// of expected, arrived and missing guests. Owner only.
+func (uc *EventUseCase) ListCheckIns(ctx context.Context, ownerID, eventID string) (*dto.CheckInListResponse, error) {
+	event, err := uc.findOwnedEvent(ctx, ownerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	invites, err := uc.eventInviteRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	checkIns, err := uc.eventCheckInRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	byInvite := make(map[string][]*entities.EventCheckIn)
+	for _, c := range checkIns {
+		byInvite[c.InviteID] = append(byInvite[c.InviteID], c)
+	}
+	resp := &dto.CheckInListResponse{Parties: []*dto.CheckInPartyResponse{}}
+	for _, invite := range invites {
+		if invite.Status != "confirmed" {
+			continue
+		}
+		party := uc.toCheckInPartyResponse(ctx, invite, byInvite[invite.ID])
+		for _, m := range party.Members {
+			resp.Expected++
+			if m.CheckedInAt != "" {
+				resp.Arrived++
+			}
+		}
+		resp.Parties = append(resp.Parties, party)
+	}
+	resp.Pending = resp.Expected - resp.Arrived
+	if eventHasPassed(event) {
+		resp.NoShow = resp.Pending
+	}
+	return resp, nil
+}
+
+// VerifyTicket checks a signed ticket and returns what it vouches for along with the party's check-in
+// state. Owner of the ticket's event only.
+func (uc *EventUseCase) VerifyTicket(ctx context.Context, ownerID, token string) (*dto.TicketVerificationResponse, error) {
+	claims, err := uc.ticketSigner.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.findOwnedEvent(ctx, ownerID, claims.EventID); err != nil {
+		return nil, err
+	}
+	invite, err := uc.findConfirmedInvite(ctx, claims.EventID, claims.InviteID)
+	if err != nil {
+		return nil, err
+	}
+	checkIns, err := uc.eventCheckInRepo.ListByInviteID(ctx, invite.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.TicketVerificationResponse{
+		EventID:   claims.EventID,
+		InviteID:  claims.InviteID,
+		PartySize: claims.PartySize,
+		ExpiresAt: claims.ExpiresAt.UTC().Format(time.RFC3339),
+		Party:     uc.toCheckInPartyResponse(ctx, invite, checkIns),
+	}, nil
+}
+
+// TicketPublicKey returns the key that verifies ticket tokens, for scanners that work offline.
+func (uc *EventUseCase) TicketPublicKey() *dto.TicketPublicKeyResponse {
+	key := uc.ticketSigner.PublicKey()
+	return &dto.TicketPublicKeyResponse{
+		KeyID:     key.KeyID,
+		Algorithm: key.Algorithm,
+		PEM:       key.PEM,
+		JWK: map[string]string{
+			"kty": "OKP",
+			"crv": "Ed25519",
+			"x":   base64.RawURLEncoding.EncodeToString(key.PublicKey),
+			"kid": key.KeyID,
+			"alg": key.Algorithm,
+			"use": "sig",
+		},
+	}
+}
+
+// ticketExpiresAt is when an event's tickets stop verifying: a day after the event ends, which leaves
+// room for late scans and for events that overrun.
+func ticketExpiresAt(event *entities.Event) time.Time {
+	_, end := event.Schedule()
+	return end.Add(24 * time.Hour)
+}
+
+// findTicketInvite verifies a signed ticket token and returns the confirmed invite it stands for.
+func (uc *EventUseCase) findTicketInvite(ctx context.Context, eventID, token string) (*entities.EventInvite, error) {
+	claims, err := uc.ticketSigner.Verify(strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+	if claims.EventID != eventID {
+		return nil, apperrors.ErrInvalidTicket
+	}
+	return uc.findConfirmedInvite(ctx, eventID, claims.InviteID)
+}
+
+// findConfirmedInvite returns the event's invite with the given ID if its guest is coming.
+func (uc *EventUseCase) findConfirmedInvite(ctx context.Context, eventID, inviteID string) (*entities.EventInvite, error) {
+	if _, err := uuid.Parse(inviteID); err != nil {
+		return nil, apperrors.ErrInvalidTicket
+	}
+	invite, err := uc.eventInviteRepo.FindByID(ctx, inviteID)
+	if err != nil || invite.EventID != eventID {
+		return nil, apperrors.ErrInvalidTicket
+	}
+	if invite.Status != "confirmed" {
+		return nil, fmt.Errorf("%w: the guest's RSVP is %s", apperrors.ErrInvalidTicket, invite.Status)
+	}
+	return invite, nil
+}
+
+// toCheckInPartyResponse lists the invite's party with the given check-ins, which must belong to it.
+func (uc *EventUseCase) toCheckInPartyResponse(ctx context.Context, invite *entities.EventInvite, checkIns []*entities.EventCheckIn) *dto.CheckInPartyResponse {
+	arrived := make(map[string]string, len(checkIns)) // companion ID ("" for the guest) -> time
+	for _, c := range checkIns {
+		key := ""
+		if c.CompanionID != nil {
+			key = *c.CompanionID
+		}
+		arrived[key] = c.CheckedInAt.UTC().Format(time.RFC3339)
+	}
+	guestName := invite.Email
+	if invite.UserID != nil {
+		if user, err := uc.userRepo.FindByID(ctx, *invite.UserID); err == nil {
+			if name := strings.TrimSpace(user.FirstName + " " + user.LastName); name != "" {
+				guestName = name
+			}
+		}
+	}
+	resp := &dto.CheckInPartyResponse{
+		InviteID:  invite.ID,
+		Email:     invite.Email,
+		GuestName: guestName,
+		Members: []*dto.CheckInMemberResponse{
+			{Name: guestName, SeatID: invite.SeatID, CheckedInAt: arrived[""]},
+		},
+	}
+	for _, c := range invite.Companions {
+		companionID := c.ID
+		resp.Members = append(resp.Members, &dto.CheckInMemberResponse{
+			CompanionID: &companionID,
+			Name:        c.Name,
+			SeatID:      c.SeatID,
+			CheckedInAt: arrived[c.ID],
+		})
+	}
+	return resp
+}
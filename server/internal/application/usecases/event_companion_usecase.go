@@ -0,0 +1,124 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// UpdateInvitePartySize lets the organizer change how many people an invite stands for. The party size
+// cannot drop below the guest plus the companions they already named.
+func (uc *EventUseCase) UpdateInvitePartySize(ctx context.Context, ownerID, eventID, inviteID string, req dto.UpdateInviteRequest) (*dto.EventInviteResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	invite, err := uc.eventInviteRepo.FindByID(ctx, inviteID)
+	if err != nil || invite.EventID != eventID {
+		return nil, errors.New("invite not found")
+	}
+	invite.PartySize = req.PartySize
+	if err := invite.Validate(); err != nil {
+		return nil, err
+	}
+	invite.UpdatedAt = time.Now()
+	if err := uc.eventInviteRepo.Update(ctx, invite); err != nil {
+		return nil, err
+	}
+	return uc.toEventInviteResponse(invite), nil
+}
+
+// applyCompanions replaces the invite's companions with the requested ones. Existing companions are
+// matched by ID so they keep their seat unless the request moves or frees it.
+func (uc *EventUseCase) applyCompanions(ctx context.Context, eventID string, invite *entities.EventInvite, reqs []dto.CompanionRequest) error {
+	if 1+len(reqs) > invite.PartySize {
+		return apperrors.ErrPartyTooLarge
+	}
+	existing := make(map[string]*entities.EventInviteCompanion, len(invite.Companions))
+	for _, c := range invite.Companions {
+		existing[c.ID] = c
+	}
+	companions := make([]*entities.EventInviteCompanion, 0, len(reqs))
+	for _, req := range reqs {
+		c := &entities.EventInviteCompanion{InviteID: invite.ID}
+		if req.ID != "" {
+			prev, ok := existing[req.ID]
+			if !ok {
+				return errors.New("companion not found")
+			}
+			delete(existing, req.ID)
+			c = prev
+		}
+		c.Name = strings.TrimSpace(req.Name)
+		if req.SeatID != nil {
+			if *req.SeatID == "" {
+				c.SeatID = nil
+			} else {
+				seat, err := uc.findEventSeat(ctx, eventID, *req.SeatID)
+				if err != nil {
+					return fmt.Errorf("companion %w", err)
+				}
+				if err := checkSeatSelectable(seat, invite.ID); err != nil {
+					return err
+				}
+				seatID := *req.SeatID
+				c.SeatID = &seatID
+			}
+		}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		companions = append(companions, c)
+	}
+	invite.Companions = companions
+	return nil
+}
+
+// findCompanion returns the invite's companion with the given ID.
+func findCompanion(invite *entities.EventInvite, companionID string) (*entities.EventInviteCompanion, error) {
+	for _, c := range invite.Companions {
+		if c.ID == companionID {
+			return c, nil
+		}
+	}
+	return nil, errors.New("companion not found")
+}
+
+// partySeats maps each seated member of the invite's party to their seat; the guest is under the nil key.
+func partySeats(invite *entities.EventInvite) map[*entities.EventInviteCompanion]string {
+	out := make(map[*entities.EventInviteCompanion]string)
+	if invite.SeatID != nil && *invite.SeatID != "" {
+		out[nil] = *invite.SeatID
+	}
+	for _, c := range invite.Companions {
+		if c.SeatID != nil && *c.SeatID != "" {
+			out[c] = *c.SeatID
+		}
+	}
+	return out
+}
+
+// checkPartySeatsDistinct rejects a party in which two members point at the same seat.
+func checkPartySeatsDistinct(invite *entities.EventInvite) error {
+	seen := make(map[string]bool)
+	for _, seatID := range inviteSeatIDs(invite) {
+		if seen[seatID] {
+			return errors.New("each member of the party needs a different seat")
+		}
+		seen[seatID] = true
+	}
+	return nil
+}
+
+// clearPartySeats frees the seats of the guest and all their companions.
+func clearPartySeats(invite *entities.EventInvite) {
+	invite.SeatID = nil
+	for _, c := range invite.Companions {
+		c.SeatID = nil
+	}
+}
@@ -0,0 +1,106 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+// DuplicateEvent copies an event to a new date together with its tables, seats, positions and floor
+// elements. With IncludeInvites the guest list is copied as fresh pending invites without seats; the
+// guests get an invite email only with NotifyInvites. Owner only; everything is created in one transaction.
+func (uc *EventUseCase) DuplicateEvent(ctx context.Context, ownerID, eventID string, req dto.DuplicateEventRequest) (*dto.EventResponse, error) {
+	src, err := uc.findOwnedEvent(ctx, ownerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	eventDate, err := time.Parse("2006-01-02", req.EventDate)
+	if err != nil {
+		return nil, errors.New("event_date must be YYYY-MM-DD")
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = fmt.Sprintf("%s (%s)", src.Name, req.EventDate)
+	}
+	exists, err := uc.eventRepo.ExistsByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("event with this name already exists")
+	}
+	floorPlan, err := uc.floorPlanData(ctx, src.ID)
+	if err != nil {
+		return nil, err
+	}
+	var invites []*entities.EventInvite
+	if req.IncludeInvites {
+		invites, err = uc.eventInviteRepo.ListByEventID(ctx, src.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	event := *src
+	event.ID = ""
+	event.Name = name
+	event.EventDate = eventDate
+	// The RSVP deadline and seat-selection window belong to the old date.
+	event.RSVPDeadline = nil
+	event.SeatSelectionOpensAt = nil
+	event.SeatSelectionClosesAt = nil
+	event.SeatingLocked = false
+	event.CalendarSequence = 0
+	event.CreatedAt = now
+	event.UpdatedAt = now
+	if err := event.Validate(); err != nil {
+		return nil, err
+	}
+	var copied []*entities.EventInvite
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.eventRepo.Create(ctx, &event); err != nil {
+			return err
+		}
+		if err := uc.buildFloorPlan(ctx, event.ID, floorPlan); err != nil {
+			return err
+		}
+		if err := uc.recordSeatingSnapshot(ctx, event.ID, ownerID, snapshotDuplicated); err != nil {
+			return err
+		}
+		copied = make([]*entities.EventInvite, 0, len(invites))
+		for _, inv := range invites {
+			invite := &entities.EventInvite{
+				EventID:         event.ID,
+				UserID:          inv.UserID,
+				Email:           inv.Email,
+				Status:          "pending",
+				PartySize:       inv.PartySize,
+				CalendarInvited: req.NotifyInvites,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+			}
+			if err := uc.eventInviteRepo.Create(ctx, invite); err != nil {
+				return err
+			}
+			copied = append(copied, invite)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.NotifyInvites {
+		rsvpPath := fmt.Sprintf("/events/%s/rsvp", event.ID)
+		organizer := uc.calendarOrganizer(ctx, &event)
+		for _, invite := range copied {
+			_ = uc.mailer.SendInviteEmail(ctx, invite.Email, event.Name, rsvpPath, calendarMessage(&event, organizer, "REQUEST", invite))
+		}
+	}
+	return uc.toEventResponse(&event), nil
+}
@@ -0,0 +1,121 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+var defaultFloorElementLabels = map[entities.FloorElementKind]string{
+	entities.FloorElementStage:      "Stage",
+	entities.FloorElementDanceFloor: "Dance Floor",
+	entities.FloorElementBar:        "Bar",
+	entities.FloorElementEntrance:   "Entrance",
+	entities.FloorElementBuffet:     "Buffet",
+	entities.FloorElementRestroom:   "Restroom",
+}
+
+// CreateFloorElement places a stage, bar, entrance or similar object in a room of the event. Owner only.
+func (uc *EventUseCase) CreateFloorElement(ctx context.Context, ownerID, eventID string, req dto.FloorElementRequest) (*dto.FloorElementResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	room, err := uc.resolveRoom(ctx, eventID, req.RoomID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	e := &entities.FloorElement{EventID: eventID, RoomID: room.ID, CreatedAt: now}
+	if err := applyFloorElementRequest(e, req, now); err != nil {
+		return nil, err
+	}
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotElementCreated, func(ctx context.Context) error {
+		return uc.floorElementRepo.Create(ctx, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toFloorElementResponse(e), nil
+}
+
+// UpdateFloorElement replaces an element's kind, label, position, size and rotation, and moves it to
+// req.RoomID if set. Owner only.
+func (uc *EventUseCase) UpdateFloorElement(ctx context.Context, ownerID, eventID, elementID string, req dto.FloorElementRequest) (*dto.FloorElementResponse, error) {
+	e, err := uc.findFloorElement(ctx, ownerID, eventID, elementID)
+	if err != nil {
+		return nil, err
+	}
+	if req.RoomID != "" && req.RoomID != e.RoomID {
+		room, err := uc.resolveRoom(ctx, eventID, req.RoomID)
+		if err != nil {
+			return nil, err
+		}
+		e.RoomID = room.ID
+	}
+	if err := applyFloorElementRequest(e, req, time.Now()); err != nil {
+		return nil, err
+	}
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotElementUpdated, func(ctx context.Context) error {
+		return uc.floorElementRepo.Update(ctx, e)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toFloorElementResponse(e), nil
+}
+
+// DeleteFloorElement removes an element from the floor. Owner only.
+func (uc *EventUseCase) DeleteFloorElement(ctx context.Context, ownerID, eventID, elementID string) error {
+	e, err := uc.findFloorElement(ctx, ownerID, eventID, elementID)
+	if err != nil {
+		return err
+	}
+	return uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotElementDeleted, func(ctx context.Context) error {
+		return uc.floorElementRepo.Delete(ctx, e)
+	})
+}
+
+func (uc *EventUseCase) findFloorElement(ctx context.Context, ownerID, eventID, elementID string) (*entities.FloorElement, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	e, err := uc.floorElementRepo.FindByID(ctx, elementID)
+	if err != nil || e.EventID != eventID {
+		return nil, errors.New("floor element not found")
+	}
+	return e, nil
+}
+
+func applyFloorElementRequest(e *entities.FloorElement, req dto.FloorElementRequest, now time.Time) error {
+	e.Kind = entities.FloorElementKind(strings.ToLower(strings.TrimSpace(req.Kind)))
+	e.Label = strings.TrimSpace(req.Label)
+	if e.Label == "" {
+		e.Label = defaultFloorElementLabels[e.Kind]
+	}
+	e.PositionX = req.PositionX
+	e.PositionY = req.PositionY
+	e.Width = req.Width
+	e.Height = req.Height
+	e.Rotation = normalizeRotation(req.Rotation)
+	e.UpdatedAt = now
+	return e.Validate()
+}
+
+func toFloorElementResponse(e *entities.FloorElement) *dto.FloorElementResponse {
+	return &dto.FloorElementResponse{
+		ID:        e.ID,
+		EventID:   e.EventID,
+		RoomID:    e.RoomID,
+		Kind:      string(e.Kind),
+		Label:     e.Label,
+		PositionX: e.PositionX,
+		PositionY: e.PositionY,
+		Width:     e.Width,
+		Height:    e.Height,
+		Rotation:  e.Rotation,
+	}
+}
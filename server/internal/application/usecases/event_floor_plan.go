@@ -0,0 +1,201 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+// floorPlanData copies the event's rooms, tables, seats and floor elements. Guests, reservations and holds
+// are left out, so the result can be rebuilt on any event with buildFloorPlan.
+func (uc *EventUseCase) floorPlanData(ctx context.Context, eventID string) (data entities.LayoutData, err error) {
+	rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return data, err
+	}
+	roomIndex := make(map[string]int, len(rooms))
+	for i, room := range rooms {
+		roomIndex[room.ID] = i
+		data.Rooms = append(data.Rooms, entities.LayoutRoom{
+			Name:         room.Name,
+			Width:        room.Width,
+			Height:       room.Height,
+			DisplayOrder: room.DisplayOrder,
+		})
+	}
+	tables, err := uc.eventTableRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return data, err
+	}
+	for _, t := range tables {
+		seats, err := uc.eventSeatRepo.ListByEventTableID(ctx, t.ID)
+		if err != nil {
+			return data, err
+		}
+		lt := entities.LayoutTable{
+			Room:            roomIndex[t.RoomID],
+			Name:            t.Name,
+			Shape:           t.Shape,
+			TableRows:       t.TableRows,
+			TableColumns:    t.TableColumns,
+			Capacity:        t.Capacity,
+			SeatLabelScheme: t.SeatLabelScheme,
+			SeatLabels:      t.SeatLabels,
+			DisplayOrder:    t.DisplayOrder,
+			PositionX:       t.PositionX,
+			PositionY:       t.PositionY,
+			Width:           t.Width,
+			Height:          t.Height,
+			Rotation:        t.Rotation,
+			Seats:           make([]entities.LayoutSeat, len(seats)),
+		}
+		for i, s := range seats {
+			lt.Seats[i] = entities.LayoutSeat{
+				Label:        s.Label,
+				DisplayOrder: s.DisplayOrder,
+				Accessible:   s.Accessible,
+				VIP:          s.VIP,
+				Blocked:      s.Blocked,
+			}
+		}
+		data.Tables = append(data.Tables, lt)
+	}
+	elements, err := uc.floorElementRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return data, err
+	}
+	for _, e := range elements {
+		data.Elements = append(data.Elements, entities.LayoutElement{
+			Room:      roomIndex[e.RoomID],
+			Kind:      e.Kind,
+			Label:     e.Label,
+			PositionX: e.PositionX,
+			PositionY: e.PositionY,
+			Width:     e.Width,
+			Height:    e.Height,
+			Rotation:  e.Rotation,
+		})
+	}
+	return data, nil
+}
+
+// buildFloorPlan creates rooms, tables, seats and floor elements on the event from layout data. The
+// layout's rooms are added after the event's existing rooms; without rooms in the data everything goes
+// into the event's default room. Call it inside a transaction.
+func (uc *EventUseCase) buildFloorPlan(ctx context.Context, eventID string, data entities.LayoutData) error {
+	now := time.Now()
+	roomIDs, err := uc.buildFloorPlanRooms(ctx, eventID, data.Rooms, now)
+	if err != nil {
+		return err
+	}
+	for _, lt := range data.Tables {
+		t := &entities.EventTable{
+			EventID:         eventID,
+			RoomID:          layoutRoomID(roomIDs, lt.Room),
+			Name:            lt.Name,
+			Shape:           lt.Shape,
+			TableRows:       lt.TableRows,
+			TableColumns:    lt.TableColumns,
+			Capacity:        lt.Capacity,
+			SeatLabelScheme: lt.SeatLabelScheme,
+			SeatLabels:      lt.SeatLabels,
+			DisplayOrder:    lt.DisplayOrder,
+			PositionX:       lt.PositionX,
+			PositionY:       lt.PositionY,
+			Width:           lt.Width,
+			Height:          lt.Height,
+			Rotation:        lt.Rotation,
+			CreatedAt:       now,
+			UpdatedAt:       now,
+		}
+		if t.SeatLabelScheme == "" {
+			t.SeatLabelScheme = entities.SeatLabelNumeric
+		}
+		if err := uc.eventTableRepo.Create(ctx, t); err != nil {
+			return err
+		}
+		seats := make([]*entities.EventSeat, len(lt.Seats))
+		for i, ls := range lt.Seats {
+			seats[i] = &entities.EventSeat{
+				EventTableID: t.ID,
+				Label:        ls.Label,
+				DisplayOrder: ls.DisplayOrder,
+				Accessible:   ls.Accessible,
+				VIP:          ls.VIP,
+				Blocked:      ls.Blocked,
+				CreatedAt:    now,
+				UpdatedAt:    now,
+			}
+		}
+		if err := uc.eventSeatRepo.CreateBulk(ctx, seats); err != nil {
+			return err
+		}
+	}
+	for _, le := range data.Elements {
+		e := &entities.FloorElement{
+			EventID:   eventID,
+			RoomID:    layoutRoomID(roomIDs, le.Room),
+			Kind:      le.Kind,
+			Label:     le.Label,
+			PositionX: le.PositionX,
+			PositionY: le.PositionY,
+			Width:     le.Width,
+			Height:    le.Height,
+			Rotation:  le.Rotation,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+		if err := uc.floorElementRepo.Create(ctx, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildFloorPlanRooms creates the layout rooms and returns their IDs by layout index. Without layout rooms
+// the result holds only the event's default room.
+func (uc *EventUseCase) buildFloorPlanRooms(ctx context.Context, eventID string, rooms []entities.LayoutRoom, now time.Time) ([]string, error) {
+	if len(rooms) == 0 {
+		room, err := uc.defaultRoom(ctx, eventID)
+		if err != nil {
+			return nil, err
+		}
+		return []string{room.ID}, nil
+	}
+	existing, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, len(rooms))
+	for i, lr := range rooms {
+		room := &entities.EventRoom{
+			EventID:      eventID,
+			Name:         lr.Name,
+			Width:        lr.Width,
+			Height:       lr.Height,
+			DisplayOrder: len(existing) + lr.DisplayOrder,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+		if room.Name == "" {
+			room.Name = defaultRoomName
+		}
+		if room.Width <= 0 || room.Height <= 0 {
+			room.Width, room.Height = defaultRoomWidth, defaultRoomHeight
+		}
+		if err := uc.eventRoomRepo.Create(ctx, room); err != nil {
+			return nil, err
+		}
+		ids[i] = room.ID
+	}
+	return ids, nil
+}
+
+// layoutRoomID maps a layout room index to the created room, falling back to the first one.
+func layoutRoomID(roomIDs []string, index int) string {
+	if index >= 0 && index < len(roomIDs) {
+		return roomIDs[index]
+	}
+	return roomIDs[0]
+}
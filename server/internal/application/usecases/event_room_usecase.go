@@ -0,0 +1,233 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+const (
+	defaultRoomName   = "Main room"
+	defaultRoomWidth  = 30.0
+	defaultRoomHeight = 20.0
+)
+
+// ListEventRooms returns the rooms of an event in display order. Same access rules as ListEventSeating.
+func (uc *EventUseCase) ListEventRooms(ctx context.Context, eventID, callerID string) ([]*dto.EventRoomResponse, error) {
+	if _, err := uc.checkSeatingAccess(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := uc.eventTableRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*dto.EventRoomResponse, len(rooms))
+	for i, room := range rooms {
+		out[i] = toEventRoomResponse(room, len(tablesInRoom(tables, room.ID)))
+	}
+	return out, nil
+}
+
+// CreateEventRoom adds a room to the event, by default after the existing ones. Owner only.
+func (uc *EventUseCase) CreateEventRoom(ctx context.Context, ownerID, eventID string, req dto.EventRoomRequest) (*dto.EventRoomResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	room := &entities.EventRoom{
+		EventID:      eventID,
+		Width:        defaultRoomWidth,
+		Height:       defaultRoomHeight,
+		DisplayOrder: len(rooms),
+		CreatedAt:    now,
+	}
+	applyEventRoomRequest(room, req, now)
+	if err := room.Validate(); err != nil {
+		return nil, err
+	}
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotRoomCreated, func(ctx context.Context) error {
+		return uc.eventRoomRepo.Create(ctx, room)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEventRoomResponse(room, 0), nil
+}
+
+// UpdateEventRoom renames, resizes or reorders a room. Table and element positions are percentages of
+// the room, so resizing keeps them where they are relative to the walls. Owner only.
+func (uc *EventUseCase) UpdateEventRoom(ctx context.Context, ownerID, eventID, roomID string, req dto.EventRoomRequest) (*dto.EventRoomResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	room, err := uc.resolveRoom(ctx, eventID, roomID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		req.Name = room.Name
+	}
+	applyEventRoomRequest(room, req, time.Now())
+	if err := room.Validate(); err != nil {
+		return nil, err
+	}
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotRoomUpdated, func(ctx context.Context) error {
+		return uc.eventRoomRepo.Update(ctx, room)
+	})
+	if err != nil {
+		return nil, err
+	}
+	tables, err := uc.eventTableRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return toEventRoomResponse(room, len(tablesInRoom(tables, room.ID))), nil
+}
+
+// DeleteEventRoom removes a room with its tables, seats and floor elements. The last room of an event
+// cannot be deleted, and a room where guests have seats fails with a *DisplacedGuestsError. Owner only.
+func (uc *EventUseCase) DeleteEventRoom(ctx context.Context, ownerID, eventID, roomID string) error {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return err
+	}
+	room, err := uc.resolveRoom(ctx, eventID, roomID)
+	if err != nil {
+		return err
+	}
+	return uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotRoomDeleted, func(ctx context.Context) error {
+		rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if len(rooms) <= 1 {
+			return errors.New("an event needs at least one room")
+		}
+		tables, err := uc.eventTableRepo.ListByEventID(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		seatToInvite := uc.seatToInviteMap(ctx, eventID)
+		var inviteIDs []string
+		seen := make(map[string]bool)
+		for _, t := range tablesInRoom(tables, room.ID) {
+			seats, err := uc.eventSeatRepo.ListByEventTableID(ctx, t.ID)
+			if err != nil {
+				return err
+			}
+			for _, s := range seats {
+				if id, ok := seatToInvite[s.ID]; ok && !seen[id] {
+					seen[id] = true
+					inviteIDs = append(inviteIDs, id)
+				}
+			}
+		}
+		if len(inviteIDs) > 0 {
+			invites, err := uc.invitesByID(ctx, inviteIDs)
+			if err != nil {
+				return err
+			}
+			return &DisplacedGuestsError{Invites: invites}
+		}
+		// Tables, seats and floor elements go with the room (ON DELETE CASCADE).
+		return uc.eventRoomRepo.Delete(ctx, room)
+	})
+}
+
+// defaultRoom returns the event's first room, creating one when the event has none yet.
+func (uc *EventUseCase) defaultRoom(ctx context.Context, eventID string) (*entities.EventRoom, error) {
+	rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rooms) > 0 {
+		return rooms[0], nil
+	}
+	now := time.Now()
+	room := &entities.EventRoom{
+		EventID:   eventID,
+		Name:      defaultRoomName,
+		Width:     defaultRoomWidth,
+		Height:    defaultRoomHeight,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := uc.eventRoomRepo.Create(ctx, room); err != nil {
+		return nil, err
+	}
+	return room, nil
+}
+
+// resolveRoom finds a room of the event; an empty roomID means the default room.
+func (uc *EventUseCase) resolveRoom(ctx context.Context, eventID, roomID string) (*entities.EventRoom, error) {
+	if roomID == "" {
+		return uc.defaultRoom(ctx, eventID)
+	}
+	room, err := uc.eventRoomRepo.FindByID(ctx, roomID)
+	if err != nil || room.EventID != eventID {
+		return nil, errors.New("room not found")
+	}
+	return room, nil
+}
+
+// findRoomTable finds a table of the event; a non-empty roomID must match the table's room.
+func (uc *EventUseCase) findRoomTable(ctx context.Context, eventID, roomID, tableID string) (*entities.EventTable, error) {
+	t, err := uc.eventTableRepo.FindByID(ctx, tableID)
+	if err != nil {
+		return nil, err
+	}
+	if t.EventID != eventID {
+		return nil, errors.New("table does not belong to this event")
+	}
+	if roomID != "" && t.RoomID != roomID {
+		return nil, errors.New("table does not belong to this room")
+	}
+	return t, nil
+}
+
+func tablesInRoom(tables []*entities.EventTable, roomID string) []*entities.EventTable {
+	out := make([]*entities.EventTable, 0, len(tables))
+	for _, t := range tables {
+		if t.RoomID == roomID {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func applyEventRoomRequest(room *entities.EventRoom, req dto.EventRoomRequest, now time.Time) {
+	room.Name = strings.TrimSpace(req.Name)
+	if req.Width != nil {
+		room.Width = *req.Width
+	}
+	if req.Height != nil {
+		room.Height = *req.Height
+	}
+	if req.DisplayOrder != nil && *req.DisplayOrder >= 0 {
+		room.DisplayOrder = *req.DisplayOrder
+	}
+	room.UpdatedAt = now
+}
+
+func toEventRoomResponse(room *entities.EventRoom, tableCount int) *dto.EventRoomResponse {
+	return &dto.EventRoomResponse{
+		ID:           room.ID,
+		EventID:      room.EventID,
+		Name:         room.Name,
+		Width:        room.Width,
+		Height:       room.Height,
+		DisplayOrder: room.DisplayOrder,
+		TableCount:   tableCount,
+	}
+}
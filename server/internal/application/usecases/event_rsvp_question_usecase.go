@@ -0,0 +1,356 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// ListRSVPQuestions returns the event's RSVP form and, when the caller has an invite, their party's saved
+// answers. Same access rules as ListEventSeating.
+func (uc *EventUseCase) ListRSVPQuestions(ctx context.Context, eventID, callerID string) (*dto.RSVPQuestionnaireResponse, error) {
+	if _, err := uc.checkSeatingAccess(ctx, eventID, callerID); err != nil {
+		return nil, err
+	}
+	questions, err := uc.rsvpQuestionRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.RSVPQuestionnaireResponse{
+		Questions: make([]*dto.RSVPQuestionResponse, len(questions)),
+		Answers:   []*dto.RSVPAnswerResponse{},
+	}
+	for i, q := range questions {
+		resp.Questions[i] = toRSVPQuestionResponse(q)
+	}
+	if callerID == "" {
+		return resp, nil
+	}
+	invite, err := uc.eventInviteRepo.FindByEventAndUser(ctx, eventID, callerID)
+	if err != nil {
+		return resp, nil
+	}
+	answers, err := uc.rsvpQuestionRepo.ListAnswersByInviteID(ctx, invite.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range answers {
+		resp.Answers = append(resp.Answers, &dto.RSVPAnswerResponse{
+			QuestionID:  a.QuestionID,
+			CompanionID: a.CompanionID,
+			Text:        a.Text,
+			Choices:     a.Choices,
+		})
+	}
+	return resp, nil
+}
+
+// CreateRSVPQuestion adds a question to the event's RSVP form, by default after the existing ones. Owner only.
+func (uc *EventUseCase) CreateRSVPQuestion(ctx context.Context, ownerID, eventID string, req dto.RSVPQuestionRequest) (*dto.RSVPQuestionResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	questions, err := uc.rsvpQuestionRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	q := &entities.RSVPQuestion{EventID: eventID, DisplayOrder: len(questions), CreatedAt: now}
+	applyRSVPQuestionRequest(q, req, now)
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.rsvpQuestionRepo.Create(ctx, q); err != nil {
+		return nil, err
+	}
+	return toRSVPQuestionResponse(q), nil
+}
+
+// UpdateRSVPQuestion changes a question. Once guests have answered, its kind can no longer change; saved
+// answers naming an option that was removed stay stored but no longer count in the summary. Owner only.
+func (uc *EventUseCase) UpdateRSVPQuestion(ctx context.Context, ownerID, eventID, questionID string, req dto.RSVPQuestionRequest) (*dto.RSVPQuestionResponse, error) {
+	q, err := uc.findOwnedRSVPQuestion(ctx, ownerID, eventID, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if entities.RSVPQuestionKind(req.Kind) != q.Kind {
+		answered, err := uc.rsvpQuestionRepo.CountAnswersByQuestionID(ctx, q.ID)
+		if err != nil {
+			return nil, err
+		}
+		if answered > 0 {
+			return nil, errors.New("cannot change the kind of a question that has answers")
+		}
+	}
+	applyRSVPQuestionRequest(q, req, time.Now())
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.rsvpQuestionRepo.Update(ctx, q); err != nil {
+		return nil, err
+	}
+	return toRSVPQuestionResponse(q), nil
+}
+
+// DeleteRSVPQuestion removes a question together with its answers. Owner only.
+func (uc *EventUseCase) DeleteRSVPQuestion(ctx context.Context, ownerID, eventID, questionID string) error {
+	q, err := uc.findOwnedRSVPQuestion(ctx, ownerID, eventID, questionID)
+	if err != nil {
+		return err
+	}
+	return uc.rsvpQuestionRepo.Delete(ctx, q)
+}
+
+// GetRSVPSummary tallies the answers of confirmed guests and their companions: picks per option for
+// choice questions and the list of answers for text questions. Owner only.
+func (uc *EventUseCase) GetRSVPSummary(ctx context.Context, ownerID, eventID string) (*dto.RSVPSummaryResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	questions, err := uc.rsvpQuestionRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	invites, err := uc.eventInviteRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	answers, err := uc.rsvpQuestionRepo.ListAnswersByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	confirmed := make(map[string]*entities.EventInvite)
+	companionNames := make(map[string]string)
+	guests, companions := 0, 0
+	for _, inv := range invites {
+		if inv.Status != "confirmed" {
+			continue
+		}
+		confirmed[inv.ID] = inv
+		guests++
+		for _, c := range inv.Companions {
+			companionNames[c.ID] = c.Name
+			companions++
+		}
+	}
+	resp := &dto.RSVPSummaryResponse{Questions: make([]*dto.RSVPQuestionSummary, len(questions))}
+	byID := make(map[string]*dto.RSVPQuestionSummary, len(questions))
+	counts := make(map[string]map[string]int, len(questions))
+	for i, q := range questions {
+		asked := guests
+		if q.PerCompanion {
+			asked += companions
+		}
+		s := &dto.RSVPQuestionSummary{Question: *toRSVPQuestionResponse(q), Unanswered: asked}
+		resp.Questions[i] = s
+		byID[q.ID] = s
+		counts[q.ID] = make(map[string]int)
+	}
+	for _, a := range answers {
+		s, inv := byID[a.QuestionID], confirmed[a.InviteID]
+		if s == nil || inv == nil {
+			continue
+		}
+		companionName := ""
+		if a.CompanionID != nil {
+			name, ok := companionNames[*a.CompanionID]
+			if !ok || !s.Question.PerCompanion {
+				continue
+			}
+			companionName = name
+		}
+		s.Answered++
+		s.Unanswered--
+		if s.Question.Kind == string(entities.RSVPQuestionText) {
+			s.Responses = append(s.Responses, &dto.RSVPTextResponse{
+				InviteID:      inv.ID,
+				Email:         inv.Email,
+				CompanionName: companionName,
+				Text:          a.Text,
+			})
+			continue
+		}
+		for _, choice := range a.Choices {
+			counts[a.QuestionID][choice]++
+		}
+	}
+	for _, s := range resp.Questions {
+		if s.Question.Kind == string(entities.RSVPQuestionText) {
+			continue
+		}
+		for _, option := range s.Question.Options {
+			s.Options = append(s.Options, &dto.RSVPOptionCount{Option: option, Count: counts[s.Question.ID][option]})
+		}
+	}
+	return resp, nil
+}
+
+// partyAnswer is an answer waiting to be saved with an RSVP. Companion is nil for the guest's own answer;
+// it is a pointer so that companions added by the RSVP get their ID when the invite is saved.
+type partyAnswer struct {
+	Companion *entities.EventInviteCompanion
+	Answer    *entities.RSVPAnswer
+}
+
+// collectRSVPAnswers works out the answers of the invite's party for a confirmation. Submitted answers
+// replace those of that party member; members who submit none keep their saved answers. Every answer must
+// fit its question and every required question must be answered, by each companion too when the question
+// is per companion. Call it after applyCompanions, which keeps invite.Companions in request order.
+func (uc *EventUseCase) collectRSVPAnswers(ctx context.Context, eventID string, invite *entities.EventInvite, isNew bool, req dto.RespondToInviteRequest) ([]partyAnswer, error) {
+	questions, err := uc.rsvpQuestionRepo.ListByEventID(ctx, eventID)
+	if err != nil || len(questions) == 0 {
+		return nil, err
+	}
+	byID := make(map[string]*entities.RSVPQuestion, len(questions))
+	for _, q := range questions {
+		byID[q.ID] = q
+	}
+	saved := make(map[string][]*entities.RSVPAnswer) // by companion ID; "" for the guest
+	if !isNew {
+		answers, err := uc.rsvpQuestionRepo.ListAnswersByInviteID(ctx, invite.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range answers {
+			key := ""
+			if a.CompanionID != nil {
+				key = *a.CompanionID
+			}
+			saved[key] = append(saved[key], a)
+		}
+	}
+	members := append([]*entities.EventInviteCompanion{nil}, invite.Companions...)
+	out := []partyAnswer{} // non-nil: the event has questions, so the answers are saved even when empty
+	for i, member := range members {
+		submitted, key := req.Answers, ""
+		if member != nil {
+			submitted, key = nil, member.ID
+			if req.Companions != nil {
+				submitted = req.Companions[i-1].Answers
+			}
+		}
+		answers := saved[key]
+		if submitted != nil {
+			if answers, err = parseRSVPAnswers(byID, submitted, member != nil); err != nil {
+				return nil, err
+			}
+		} else if member != nil && member.ID == "" {
+			answers = nil
+		}
+		answered := make(map[string]bool, len(answers))
+		for _, a := range answers {
+			answered[a.QuestionID] = true
+			out = append(out, partyAnswer{Companion: member, Answer: a})
+		}
+		for _, q := range questions {
+			if q.Required && (member == nil || q.PerCompanion) && !answered[q.ID] {
+				if member != nil {
+					return nil, fmt.Errorf("%w: %s (for %s)", apperrors.ErrRSVPAnswerRequired, q.Prompt, member.Name)
+				}
+				return nil, fmt.Errorf("%w: %s", apperrors.ErrRSVPAnswerRequired, q.Prompt)
+			}
+		}
+	}
+	return out, nil
+}
+
+// parseRSVPAnswers checks one party member's submitted answers against the questions. Empty answers are
+// dropped; they count as unanswered.
+func parseRSVPAnswers(questions map[string]*entities.RSVPQuestion, reqs []dto.RSVPAnswerRequest, companion bool) ([]*entities.RSVPAnswer, error) {
+	seen := make(map[string]bool, len(reqs))
+	var out []*entities.RSVPAnswer
+	for _, r := range reqs {
+		q := questions[r.QuestionID]
+		if q == nil {
+			return nil, fmt.Errorf("%w: unknown question", apperrors.ErrInvalidRSVPAnswer)
+		}
+		if companion && !q.PerCompanion {
+			return nil, fmt.Errorf("%w: %s is not asked of companions", apperrors.ErrInvalidRSVPAnswer, q.Prompt)
+		}
+		if seen[q.ID] {
+			return nil, fmt.Errorf("%w: %s is answered twice", apperrors.ErrInvalidRSVPAnswer, q.Prompt)
+		}
+		seen[q.ID] = true
+		a := &entities.RSVPAnswer{QuestionID: q.ID, Text: strings.TrimSpace(r.Text), Choices: entities.StringList(r.Choices)}
+		if a.Text == "" && len(a.Choices) == 0 {
+			continue
+		}
+		if err := q.CheckAnswer(a); err != nil {
+			return nil, fmt.Errorf("%w: %s", err, q.Prompt)
+		}
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+// saveRSVPAnswers stores the party's answers, replacing all earlier ones. Call it inside a transaction
+// after the invite and its companions have been saved.
+func (uc *EventUseCase) saveRSVPAnswers(ctx context.Context, invite *entities.EventInvite, answers []partyAnswer) error {
+	now := time.Now()
+	rows := make([]*entities.RSVPAnswer, len(answers))
+	for i, pa := range answers {
+		a := *pa.Answer
+		a.ID = ""
+		a.InviteID = invite.ID
+		a.CompanionID = nil
+		if pa.Companion != nil {
+			companionID := pa.Companion.ID
+			a.CompanionID = &companionID
+		}
+		if a.CreatedAt.IsZero() {
+			a.CreatedAt = now
+		}
+		a.UpdatedAt = now
+		rows[i] = &a
+	}
+	return uc.rsvpQuestionRepo.ReplaceInviteAnswers(ctx, invite.ID, rows)
+}
+
+func (uc *EventUseCase) findOwnedRSVPQuestion(ctx context.Context, ownerID, eventID, questionID string) (*entities.RSVPQuestion, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	q, err := uc.rsvpQuestionRepo.FindByID(ctx, questionID)
+	if err != nil || q.EventID != eventID {
+		return nil, errors.New("question not found")
+	}
+	return q, nil
+}
+
+func applyRSVPQuestionRequest(q *entities.RSVPQuestion, req dto.RSVPQuestionRequest, now time.Time) {
+	q.Prompt = strings.TrimSpace(req.Prompt)
+	q.Kind = entities.RSVPQuestionKind(req.Kind)
+	q.Options = make(entities.StringList, 0, len(req.Options))
+	for _, o := range req.Options {
+		q.Options = append(q.Options, strings.TrimSpace(o))
+	}
+	q.Required = req.Required
+	q.PerCompanion = req.PerCompanion
+	if req.DisplayOrder != nil {
+		q.DisplayOrder = *req.DisplayOrder
+	}
+	q.UpdatedAt = now
+}
+
+func toRSVPQuestionResponse(q *entities.RSVPQuestion) *dto.RSVPQuestionResponse {
+	options := []string(q.Options)
+	if options == nil {
+		options = []string{}
+	}
+	return &dto.RSVPQuestionResponse{
+		ID:           q.ID,
+		EventID:      q.EventID,
+		Prompt:       q.Prompt,
+		Kind:         string(q.Kind),
+		Options:      options,
+		Required:     q.Required,
+		PerCompanion: q.PerCompanion,
+		DisplayOrder: q.DisplayOrder,
+	}
+}
@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// SetSeatingLocked freezes or unfreezes guest seat changes while the organizer finalises the chart.
+// Organizer seat operations keep working while seating is locked. Owner only.
+func (uc *EventUseCase) SetSeatingLocked(ctx context.Context, ownerID, eventID string, locked bool) (*dto.EventResponse, error) {
+	var event *entities.Event
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if event, err = uc.eventRepo.LockByID(ctx, eventID); err != nil {
+			return err
+		}
+		if event.OwnerID != ownerID {
+			return errors.New("you are not the owner of this event")
+		}
+		event.SeatingLocked = locked
+		event.UpdatedAt = time.Now()
+		return uc.eventRepo.Update(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return uc.toEventResponse(event), nil
+}
+
+// checkRSVPOpen rejects confirmations after the RSVP deadline. Guests who already confirmed may still
+// update their RSVP, and declining is always allowed. previous is the invite's status before this RSVP.
+func checkRSVPOpen(event *entities.Event, previous, status string, now time.Time) error {
+	if status != "confirmed" || previous == "confirmed" {
+		return nil
+	}
+	if event.RSVPDeadline != nil && now.After(*event.RSVPDeadline) {
+		return apperrors.ErrRSVPClosed
+	}
+	return nil
+}
+
+// checkSeatSelectionOpen rejects guest seat changes while seating is locked or outside the event's
+// seat-selection window.
+func checkSeatSelectionOpen(event *entities.Event, now time.Time) error {
+	if event.SeatingLocked {
+		return apperrors.ErrSeatingLocked
+	}
+	if event.SeatSelectionOpensAt != nil && now.Before(*event.SeatSelectionOpensAt) {
+		return apperrors.ErrSeatSelectionNotOpen
+	}
+	if event.SeatSelectionClosesAt != nil && !now.Before(*event.SeatSelectionClosesAt) {
+		return apperrors.ErrSeatSelectionClosed
+	}
+	return nil
+}
+
+// parseOptionalTime parses an RFC 3339 timestamp; an empty string means no value.
+func parseOptionalTime(field, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, errors.New(field + " must be an RFC 3339 timestamp")
+	}
+	t = t.UTC()
+	return &t, nil
+}
+
+// applyRSVPRules sets the RSVP deadline, seat-selection window and seating lock from a request.
+func applyRSVPRules(event *entities.Event, rsvpDeadline, opensAt, closesAt string, locked bool) error {
+	var err error
+	if event.RSVPDeadline, err = parseOptionalTime("rsvp_deadline", rsvpDeadline); err != nil {
+		return err
+	}
+	if event.SeatSelectionOpensAt, err = parseOptionalTime("seat_selection_opens_at", opensAt); err != nil {
+		return err
+	}
+	if event.SeatSelectionClosesAt, err = parseOptionalTime("seat_selection_closes_at", closesAt); err != nil {
+		return err
+	}
+	event.SeatingLocked = locked
+	return nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
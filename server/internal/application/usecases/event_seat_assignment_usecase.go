@@ -0,0 +1,259 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// seatOccupant is the invite sitting in a seat; Companion is set when it is one of their companions' seat.
+type seatOccupant struct {
+	Invite    *entities.EventInvite
+	Companion *entities.EventInviteCompanion
+}
+
+// AssignSeat lets the organizer seat any invite of their event, including email-only invites, or one of
+// the invite's companions. A guest who already has a seat is moved. Organizer assignments ignore seat holds
+// and reservations, but never use a blocked seat.
+func (uc *EventUseCase) AssignSeat(ctx context.Context, ownerID, eventID string, req dto.AssignSeatRequest) ([]*dto.EventInviteResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	seat, err := uc.findEventSeat(ctx, eventID, req.SeatID)
+	if err != nil {
+		return nil, err
+	}
+	if seat.Blocked {
+		return nil, apperrors.ErrSeatBlocked
+	}
+	var invite *entities.EventInvite
+	var before map[string]string
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotSeatAssigned, func(ctx context.Context) error {
+		before = uc.seatToInviteMap(ctx, eventID)
+		var err error
+		invite, err = uc.eventInviteRepo.FindByID(ctx, req.InviteID)
+		if err != nil || invite.EventID != eventID {
+			return errors.New("invite not found")
+		}
+		if invite.Status == "declined" {
+			return errors.New("cannot seat a guest who declined")
+		}
+		if invite.Status == inviteStatusWaitlisted {
+			return errors.New("cannot seat a guest who is on the waitlist")
+		}
+		var companion *entities.EventInviteCompanion
+		if req.CompanionID != "" {
+			if companion, err = findCompanion(invite, req.CompanionID); err != nil {
+				return err
+			}
+			if invite.SeatID == nil || *invite.SeatID == "" {
+				return errors.New("seat the guest before their companions")
+			}
+		}
+		if err := uc.eventSeatRepo.LockByIDs(ctx, append(inviteSeatIDs(invite), req.SeatID)); err != nil {
+			return err
+		}
+		occupants, err := uc.seatOccupants(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if occ, ok := occupants[req.SeatID]; ok {
+			switch {
+			case occ.Invite.ID != invite.ID:
+				return apperrors.ErrSeatTaken
+			case occ.Companion == nil && companion != nil:
+				return errors.New("a companion cannot take the guest's own seat")
+			case occ.Companion != nil && occ.Companion.ID != req.CompanionID:
+				// The seat goes from one member of the party to another.
+				if c, err := findCompanion(invite, occ.Companion.ID); err == nil {
+					c.SeatID = nil
+				}
+			}
+		}
+		setOccupantSeat(&seatOccupant{Invite: invite, Companion: companion}, req.SeatID)
+		return uc.reseatInvites(ctx, []*entities.EventInvite{invite})
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.notifySeatChanges(ctx, eventID, before)
+	return []*dto.EventInviteResponse{uc.toEventInviteResponse(invite)}, nil
+}
+
+// UnassignSeat frees a seat. Freeing a guest's own seat also frees the seats of their companions.
+func (uc *EventUseCase) UnassignSeat(ctx context.Context, ownerID, eventID string, req dto.UnassignSeatRequest) ([]*dto.EventInviteResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	if _, err := uc.findEventSeat(ctx, eventID, req.SeatID); err != nil {
+		return nil, err
+	}
+	var invite *entities.EventInvite
+	var before map[string]string
+	err := uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotSeatUnassigned, func(ctx context.Context) error {
+		before = uc.seatToInviteMap(ctx, eventID)
+		if err := uc.eventSeatRepo.LockByIDs(ctx, []string{req.SeatID}); err != nil {
+			return err
+		}
+		occupants, err := uc.seatOccupants(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		occ, ok := occupants[req.SeatID]
+		if !ok {
+			return errors.New("seat is not assigned")
+		}
+		invite = occ.Invite
+		if occ.Companion != nil {
+			occ.Companion.SeatID = nil
+		} else {
+			clearPartySeats(invite)
+		}
+		return uc.reseatInvites(ctx, []*entities.EventInvite{invite})
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.notifySeatChanges(ctx, eventID, before)
+	return []*dto.EventInviteResponse{uc.toEventInviteResponse(invite)}, nil
+}
+
+// MoveSeat moves the occupant of one seat to a free seat of the same event.
+func (uc *EventUseCase) MoveSeat(ctx context.Context, ownerID, eventID string, req dto.MoveSeatRequest) ([]*dto.EventInviteResponse, error) {
+	return uc.exchangeSeats(ctx, ownerID, eventID, req.FromSeatID, req.ToSeatID, true)
+}
+
+// SwapSeats exchanges the occupants of two seats of the same event.
+func (uc *EventUseCase) SwapSeats(ctx context.Context, ownerID, eventID string, req dto.SwapSeatsRequest) ([]*dto.EventInviteResponse, error) {
+	return uc.exchangeSeats(ctx, ownerID, eventID, req.FirstSeatID, req.SecondSeatID, false)
+}
+
+// exchangeSeats puts the occupant of seatA in seatB and the occupant of seatB in seatA. With
+// targetMustBeFree, seatA must be taken and seatB free (a move).
+func (uc *EventUseCase) exchangeSeats(ctx context.Context, ownerID, eventID, seatA, seatB string, targetMustBeFree bool) ([]*dto.EventInviteResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	if seatA == seatB {
+		return nil, errors.New("seats must be different")
+	}
+	for _, seatID := range []string{seatA, seatB} {
+		seat, err := uc.findEventSeat(ctx, eventID, seatID)
+		if err != nil {
+			return nil, err
+		}
+		// Only someone already sitting in a blocked seat may be moved out of it.
+		if seat.Blocked && (seatID == seatB || !targetMustBeFree) {
+			return nil, apperrors.ErrSeatBlocked
+		}
+	}
+	reason := snapshotSeatsSwapped
+	if targetMustBeFree {
+		reason = snapshotSeatMoved
+	}
+	var changed []*entities.EventInvite
+	var before map[string]string
+	err := uc.withSeatingSnapshot(ctx, eventID, ownerID, reason, func(ctx context.Context) error {
+		before = uc.seatToInviteMap(ctx, eventID)
+		if err := uc.eventSeatRepo.LockByIDs(ctx, []string{seatA, seatB}); err != nil {
+			return err
+		}
+		occupants, err := uc.seatOccupants(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		occA, hasA := occupants[seatA]
+		occB, hasB := occupants[seatB]
+		switch {
+		case targetMustBeFree && !hasA:
+			return errors.New("seat is not assigned")
+		case targetMustBeFree && hasB:
+			return apperrors.ErrSeatTaken
+		case !hasA && !hasB:
+			return errors.New("both seats are empty")
+		}
+		changed = nil
+		if hasA {
+			setOccupantSeat(occA, seatB)
+			changed = append(changed, occA.Invite)
+		}
+		if hasB {
+			setOccupantSeat(occB, seatA)
+			// Swapping a guest with their own companion touches a single invite.
+			if !hasA || occB.Invite != occA.Invite {
+				changed = append(changed, occB.Invite)
+			}
+		}
+		return uc.reseatInvites(ctx, changed)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.notifySeatChanges(ctx, eventID, before)
+	out := make([]*dto.EventInviteResponse, len(changed))
+	for i, invite := range changed {
+		out[i] = uc.toEventInviteResponse(invite)
+	}
+	return out, nil
+}
+
+// seatOccupants maps seat ID to its occupant for the event. Each invite is loaded once, so a guest and
+// their companions share the same *EventInvite.
+func (uc *EventUseCase) seatOccupants(ctx context.Context, eventID string) (map[string]*seatOccupant, error) {
+	assignments, err := uc.eventSeatRepo.ListAssignmentsByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	invites := make(map[string]*entities.EventInvite)
+	out := make(map[string]*seatOccupant, len(assignments))
+	for _, a := range assignments {
+		invite, ok := invites[a.InviteID]
+		if !ok {
+			invite, err = uc.eventInviteRepo.FindByID(ctx, a.InviteID)
+			if err != nil {
+				return nil, err
+			}
+			invites[a.InviteID] = invite
+		}
+		occ := &seatOccupant{Invite: invite}
+		if a.CompanionID != nil {
+			if occ.Companion, err = findCompanion(invite, *a.CompanionID); err != nil {
+				return nil, err
+			}
+		}
+		out[a.SeatID] = occ
+	}
+	return out, nil
+}
+
+func setOccupantSeat(occ *seatOccupant, seatID string) {
+	if occ.Companion != nil {
+		occ.Companion.SeatID = &seatID
+	} else {
+		occ.Invite.SeatID = &seatID
+	}
+}
+
+// reseatInvites saves the invites and their seat assignments. Everyone's current seats are released
+// before any are claimed, so guests can trade places. Call it inside a transaction.
+func (uc *EventUseCase) reseatInvites(ctx context.Context, invites []*entities.EventInvite) error {
+	for _, invite := range invites {
+		if err := uc.eventSeatRepo.ReplaceInviteAssignments(ctx, invite.ID, nil); err != nil {
+			return err
+		}
+	}
+	for _, invite := range invites {
+		invite.UpdatedAt = time.Now()
+		if err := uc.eventInviteRepo.Update(ctx, invite); err != nil {
+			return err
+		}
+		if err := uc.syncSeatAssignments(ctx, invite); err != nil {
+			return err
+		}
+	}
+	return nil
+}
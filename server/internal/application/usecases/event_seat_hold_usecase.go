@@ -0,0 +1,163 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+const (
+	defaultSeatHoldMinutes = 5
+	maxSeatHoldMinutes     = 15
+)
+
+// HoldSeats holds seats for the caller for a few minutes while they finish their RSVP. Any earlier holds
+// the caller had for this event are replaced. A guest may hold one seat per member of their party.
+// Confirming through RespondToInvite turns the hold into an assignment; otherwise it expires.
+func (uc *EventUseCase) HoldSeats(ctx context.Context, userID, eventID string, req dto.SeatHoldRequest) (*dto.SeatHoldResponse, error) {
+	if len(req.SeatIDs) == 0 {
+		return nil, errors.New("seat_ids is required")
+	}
+	minutes := req.Minutes
+	if minutes <= 0 {
+		minutes = defaultSeatHoldMinutes
+	}
+	if minutes > maxSeatHoldMinutes {
+		return nil, errors.New("seats can be held for at most 15 minutes")
+	}
+	event, err := uc.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if eventHasPassed(event) {
+		return nil, errors.New("cannot hold seats for an event that has already passed")
+	}
+	if err := checkSeatSelectionOpen(event, time.Now()); err != nil {
+		return nil, err
+	}
+	invite, isNew, err := uc.findOrCreateRSVPInvite(ctx, event, userID, "pending")
+	if err != nil {
+		return nil, err
+	}
+	if len(req.SeatIDs) > invite.PartySize {
+		return nil, fmt.Errorf("you can hold at most %d seats", invite.PartySize)
+	}
+	ownInviteID := ""
+	if !isNew {
+		ownInviteID = invite.ID
+	}
+	seen := make(map[string]bool, len(req.SeatIDs))
+	for _, seatID := range req.SeatIDs {
+		if seen[seatID] {
+			return nil, errors.New("seat_ids must be different")
+		}
+		seen[seatID] = true
+		seat, err := uc.findEventSeat(ctx, eventID, seatID)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkSeatSelectable(seat, ownInviteID); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	expiresAt := now.Add(time.Duration(minutes) * time.Minute)
+	err = uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.eventSeatRepo.LockByIDs(ctx, req.SeatIDs); err != nil {
+			return err
+		}
+		assignments, err := uc.eventSeatRepo.ListAssignmentsByEventID(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		for _, a := range assignments {
+			if seen[a.SeatID] && a.InviteID != ownInviteID {
+				return apperrors.ErrSeatTaken
+			}
+		}
+		if err := uc.checkSeatHolds(ctx, eventID, userID, req.SeatIDs, now); err != nil {
+			return err
+		}
+		holds := make([]*entities.EventSeatHold, len(req.SeatIDs))
+		for i, seatID := range req.SeatIDs {
+			holds[i] = &entities.EventSeatHold{
+				SeatID:    seatID,
+				ExpiresAt: expiresAt,
+				CreatedAt: now,
+			}
+		}
+		return uc.eventSeatRepo.ReplaceUserHolds(ctx, eventID, userID, holds, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.SeatHoldResponse{
+		EventID:   eventID,
+		SeatIDs:   req.SeatIDs,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// ReleaseSeatHolds drops all of the caller's holds for the event.
+func (uc *EventUseCase) ReleaseSeatHolds(ctx context.Context, userID, eventID string) error {
+	return uc.eventSeatRepo.DeleteUserHolds(ctx, eventID, userID)
+}
+
+// checkSeatHolds returns errors.ErrSeatHeld if any of the seats has an active hold by a different user.
+func (uc *EventUseCase) checkSeatHolds(ctx context.Context, eventID, userID string, seatIDs []string, now time.Time) error {
+	if len(seatIDs) == 0 {
+		return nil
+	}
+	holds, err := uc.eventSeatRepo.ListActiveHoldsByEventID(ctx, eventID, now)
+	if err != nil {
+		return err
+	}
+	for _, h := range holds {
+		if h.UserID == userID {
+			continue
+		}
+		for _, seatID := range seatIDs {
+			if h.SeatID == seatID {
+				return apperrors.ErrSeatHeld
+			}
+		}
+	}
+	return nil
+}
+
+// seatHoldMap maps seat ID to hold expiry for the event's active holds.
+func (uc *EventUseCase) seatHoldMap(ctx context.Context, eventID string) map[string]time.Time {
+	holds, _ := uc.eventSeatRepo.ListActiveHoldsByEventID(ctx, eventID, time.Now())
+	out := make(map[string]time.Time, len(holds))
+	for _, h := range holds {
+		out[h.SeatID] = h.ExpiresAt
+	}
+	return out
+}
+
+// RunSeatHoldExpiry deletes expired seat holds every interval until ctx is cancelled.
+func (uc *EventUseCase) RunSeatHoldExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := uc.eventSeatRepo.DeleteExpiredHolds(ctx, time.Now())
+			if err != nil {
+				log.Printf("seat hold expiry: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("seat hold expiry: released %d seat(s)", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"context"
+	"sort"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+)
+
+// CanViewSeating reports whether the caller may watch the event's seating chart; the rules are those of
+// ListEventSeating. callerID is empty for anonymous viewers.
+func (uc *EventUseCase) CanViewSeating(ctx context.Context, eventID, callerID string) error {
+	_, err := uc.checkSeatingAccess(ctx, eventID, callerID)
+	return err
+}
+
+// notifySeatChanges announces every seat whose occupant differs from before, a seatToInviteMap taken
+// before the change: released seats first, then claimed ones.
+func (uc *EventUseCase) notifySeatChanges(ctx context.Context, eventID string, before map[string]string) {
+	after := uc.seatToInviteMap(ctx, eventID)
+	var released, claimed []string
+	for seatID := range before {
+		if _, ok := after[seatID]; !ok {
+			released = append(released, seatID)
+		}
+	}
+	for seatID, inviteID := range after {
+		if before[seatID] != inviteID {
+			claimed = append(claimed, seatID)
+		}
+	}
+	sort.Strings(released)
+	sort.Strings(claimed)
+	for _, seatID := range released {
+		uc.seatingNotifier.NotifySeating(ctx, services.SeatingEvent{
+			Type:     services.SeatingSeatReleased,
+			EventID:  eventID,
+			SeatID:   seatID,
+			InviteID: before[seatID],
+		})
+	}
+	for _, seatID := range claimed {
+		uc.seatingNotifier.NotifySeating(ctx, services.SeatingEvent{
+			Type:     services.SeatingSeatClaimed,
+			EventID:  eventID,
+			SeatID:   seatID,
+			InviteID: after[seatID],
+		})
+	}
+}
+
+// notifySeatingChanged tells viewers to reload the whole chart after a bulk change.
+func (uc *EventUseCase) notifySeatingChanged(ctx context.Context, eventID string) {
+	uc.seatingNotifier.NotifySeating(ctx, services.SeatingEvent{Type: services.SeatingChanged, EventID: eventID})
+}
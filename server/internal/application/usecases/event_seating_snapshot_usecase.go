@@ -0,0 +1,571 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+// maxSeatingSnapshots is how many snapshots of the organizer's changes are kept per event, and
+// maxRSVPSnapshots how many of those taken after guest RSVPs. Each budget is pruned on its own, so a
+// busy RSVP period cannot push the organizer's layout history out.
+const (
+	maxSeatingSnapshots = 100
+	maxRSVPSnapshots    = 100
+)
+
+// Snapshot reasons, one per kind of seating change.
+const (
+	snapshotInitial         = "initial"
+	snapshotRoomCreated     = "room_created"
+	snapshotRoomUpdated     = "room_updated"
+	snapshotRoomDeleted     = "room_deleted"
+	snapshotTableCreated    = "table_created"
+	snapshotTableUpdated    = "table_updated"
+	snapshotTableDeleted    = "table_deleted"
+	snapshotTablesReordered = "tables_reordered"
+	snapshotSeatUpdated     = "seat_updated"
+	snapshotElementCreated  = "element_created"
+	snapshotElementUpdated  = "element_updated"
+	snapshotElementDeleted  = "element_deleted"
+	snapshotSeatAssigned    = "seat_assigned"
+	snapshotSeatUnassigned  = "seat_unassigned"
+	snapshotSeatMoved       = "seat_moved"
+	snapshotSeatsSwapped    = "seats_swapped"
+	snapshotPlanApplied     = "seating_plan_applied"
+	snapshotRSVP            = "rsvp"
+	snapshotLayoutApplied   = "layout_applied"
+	snapshotDuplicated      = "duplicated"
+	snapshotRestored        = "restored"
+)
+
+// withSeatingSnapshot runs fn in a transaction and then records the resulting seating state. The first
+// change of an event also records the state before it, so that one can be restored too.
+func (uc *EventUseCase) withSeatingSnapshot(ctx context.Context, eventID, actorID, reason string, fn func(ctx context.Context) error) error {
+	return uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		exists, err := uc.seatingSnapshotRepo.ExistsByEventID(ctx, eventID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if err := uc.recordSeatingSnapshot(ctx, eventID, "", snapshotInitial); err != nil {
+				return err
+			}
+		}
+		if err := fn(ctx); err != nil {
+			return err
+		}
+		return uc.recordSeatingSnapshot(ctx, eventID, actorID, reason)
+	})
+}
+
+// recordSeatingSnapshot stores the event's current seating state and prunes old snapshots of the same
+// kind (RSVP or not). An empty actorID records a system change.
+func (uc *EventUseCase) recordSeatingSnapshot(ctx context.Context, eventID, actorID, reason string) error {
+	data, err := uc.seatingSnapshotData(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	s := &entities.SeatingSnapshot{
+		EventID:   eventID,
+		Reason:    reason,
+		Data:      data,
+		CreatedAt: time.Now(),
+	}
+	if actorID != "" {
+		s.CreatedBy = &actorID
+	}
+	if err := uc.seatingSnapshotRepo.Create(ctx, s); err != nil {
+		return err
+	}
+	if reason == snapshotRSVP {
+		return uc.seatingSnapshotRepo.DeleteAllButLatestWithReason(ctx, eventID, snapshotRSVP, maxRSVPSnapshots)
+	}
+	return uc.seatingSnapshotRepo.DeleteAllButLatest(ctx, eventID, maxSeatingSnapshots, snapshotRSVP)
+}
+
+func (uc *EventUseCase) seatingSnapshotData(ctx context.Context, eventID string) (data entities.SeatingSnapshotData, err error) {
+	rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return data, err
+	}
+	for _, room := range rooms {
+		data.Rooms = append(data.Rooms, *room)
+	}
+	tables, err := uc.eventTableRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return data, err
+	}
+	for _, t := range tables {
+		data.Tables = append(data.Tables, *t)
+	}
+	seats, err := uc.eventSeatRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return data, err
+	}
+	for _, s := range seats {
+		data.Seats = append(data.Seats, *s)
+	}
+	elements, err := uc.floorElementRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return data, err
+	}
+	for _, e := range elements {
+		data.Elements = append(data.Elements, *e)
+	}
+	assignments, err := uc.eventSeatRepo.ListAssignmentsByEventID(ctx, eventID)
+	if err != nil {
+		return data, err
+	}
+	for _, a := range assignments {
+		data.Assignments = append(data.Assignments, *a)
+	}
+	return data, nil
+}
+
+// ListSeatingSnapshots returns the event's seating versions, newest first. Owner only.
+func (uc *EventUseCase) ListSeatingSnapshots(ctx context.Context, ownerID, eventID string, limit, offset int) (*dto.PaginatedSeatingSnapshotsResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	list, total, err := uc.seatingSnapshotRepo.ListByEventID(ctx, eventID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*dto.SeatingSnapshotResponse, len(list))
+	for i, s := range list {
+		out[i] = toSeatingSnapshotResponse(s)
+	}
+	return &dto.PaginatedSeatingSnapshotsResponse{Items: out, Total: total}, nil
+}
+
+// DiffSeatingSnapshots compares two snapshots of the event: rooms, tables, seats and floor elements that
+// were added, removed or changed, and guests whose seat differs. Owner only.
+func (uc *EventUseCase) DiffSeatingSnapshots(ctx context.Context, ownerID, eventID, fromID, toID string) (*dto.SeatingDiffResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	from, err := uc.findSeatingSnapshot(ctx, eventID, fromID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := uc.findSeatingSnapshot(ctx, eventID, toID)
+	if err != nil {
+		return nil, err
+	}
+	invites, err := uc.eventInviteRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	emails := make(map[string]string, len(invites))
+	for _, inv := range invites {
+		emails[inv.ID] = inv.Email
+	}
+	return &dto.SeatingDiffResponse{
+		From:        toSeatingSnapshotResponse(from),
+		To:          toSeatingSnapshotResponse(to),
+		Changes:     diffSeatingLayout(from.Data, to.Data),
+		Assignments: diffSeatingAssignments(from.Data, to.Data, emails),
+	}, nil
+}
+
+// RestoreSeatingSnapshot replaces the event's rooms, tables, seats and floor elements with the snapshot's,
+// keeping their IDs, and seats guests where the snapshot had them. Guests who have declined or been
+// removed since are not seated again, and guests seated now who had no seat in the snapshot lose theirs.
+// Runs in one transaction and records a new snapshot. Owner only.
+func (uc *EventUseCase) RestoreSeatingSnapshot(ctx context.Context, ownerID, eventID, snapshotID string) (*dto.RestoreSeatingSnapshotResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	snapshot, err := uc.findSeatingSnapshot(ctx, eventID, snapshotID)
+	if err != nil {
+		return nil, err
+	}
+	var skipped, unseated []string
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotRestored, func(ctx context.Context) error {
+		// Serializes the restore with RSVPs and event edits, which lock the same row.
+		if _, err := uc.eventRepo.LockByID(ctx, eventID); err != nil {
+			return err
+		}
+		skipped, unseated, err = uc.restoreSeatingData(ctx, eventID, snapshot.Data)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.notifySeatingChanged(ctx, eventID)
+	latest, _, err := uc.seatingSnapshotRepo.ListByEventID(ctx, eventID, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	seating, err := uc.ListEventSeating(ctx, eventID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.RestoreSeatingSnapshotResponse{Seating: seating, SkippedInviteIDs: skipped, UnseatedInviteIDs: unseated}
+	if len(latest) > 0 {
+		resp.Snapshot = toSeatingSnapshotResponse(latest[0])
+	}
+	return resp, nil
+}
+
+// restoreSeatingData rebuilds the seating from snapshot data. It returns the IDs of invites that could not
+// be seated again, and of invites that had a seat before the restore and have none after it. Call it
+// inside a transaction.
+func (uc *EventUseCase) restoreSeatingData(ctx context.Context, eventID string, data entities.SeatingSnapshotData) (skipped, unseated []string, err error) {
+	before, err := uc.eventInviteRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Tables, seats, elements, assignments and holds go with the rooms (ON DELETE CASCADE); invites and
+	// companions pointing at the seats are cleared (ON DELETE SET NULL).
+	for _, room := range rooms {
+		if err := uc.eventRoomRepo.Delete(ctx, room); err != nil {
+			return nil, nil, err
+		}
+	}
+	invites, err := uc.eventInviteRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	inviteByID := make(map[string]*entities.EventInvite, len(invites))
+	for _, inv := range invites {
+		inviteByID[inv.ID] = inv
+	}
+	now := time.Now()
+	for _, room := range data.Rooms {
+		room.UpdatedAt = now
+		if err := uc.eventRoomRepo.Create(ctx, &room); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, t := range data.Tables {
+		t.UpdatedAt = now
+		if err := uc.eventTableRepo.Create(ctx, &t); err != nil {
+			return nil, nil, err
+		}
+	}
+	seats := make([]*entities.EventSeat, len(data.Seats))
+	for i := range data.Seats {
+		s := data.Seats[i]
+		if s.ReservedInviteID != nil && inviteByID[*s.ReservedInviteID] == nil {
+			s.ReservedInviteID = nil
+		}
+		s.UpdatedAt = now
+		seats[i] = &s
+	}
+	if err := uc.eventSeatRepo.CreateBulk(ctx, seats); err != nil {
+		return nil, nil, err
+	}
+	for _, e := range data.Elements {
+		e.UpdatedAt = now
+		if err := uc.floorElementRepo.Create(ctx, &e); err != nil {
+			return nil, nil, err
+		}
+	}
+	var reseat []*entities.EventInvite
+	seen := make(map[string]bool)
+	for _, a := range data.Assignments {
+		inv := inviteByID[a.InviteID]
+		if inv == nil || inv.Status == "declined" || inv.Status == inviteStatusWaitlisted {
+			if !seen[a.InviteID] {
+				skipped = append(skipped, a.InviteID)
+			}
+			seen[a.InviteID] = true
+			continue
+		}
+		seatID := a.SeatID
+		if a.CompanionID != nil {
+			companion, err := findCompanion(inv, *a.CompanionID)
+			if err != nil {
+				// The guest has since removed this companion; the rest of the party is still seated.
+				continue
+			}
+			companion.SeatID = &seatID
+		} else {
+			inv.SeatID = &seatID
+		}
+		if !seen[a.InviteID] {
+			reseat = append(reseat, inv)
+		}
+		seen[a.InviteID] = true
+	}
+	seated := make(map[string]bool, len(reseat))
+	for _, inv := range reseat {
+		inv.UpdatedAt = now
+		if err := uc.eventInviteRepo.Update(ctx, inv); err != nil {
+			return nil, nil, err
+		}
+		if err := uc.syncSeatAssignments(ctx, inv); err != nil {
+			return nil, nil, err
+		}
+		seated[inv.ID] = inviteHasSeat(inv)
+	}
+	for _, inv := range before {
+		if inviteHasSeat(inv) && !seated[inv.ID] {
+			unseated = append(unseated, inv.ID)
+		}
+	}
+	return skipped, unseated, nil
+}
+
+// inviteHasSeat reports whether the guest or any of their companions has a seat.
+func inviteHasSeat(inv *entities.EventInvite) bool {
+	if inv.SeatID != nil {
+		return true
+	}
+	for _, c := range inv.Companions {
+		if c.SeatID != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (uc *EventUseCase) findSeatingSnapshot(ctx context.Context, eventID, snapshotID string) (*entities.SeatingSnapshot, error) {
+	s, err := uc.seatingSnapshotRepo.FindByID(ctx, snapshotID)
+	if err != nil || s.EventID != eventID {
+		return nil, errors.New("snapshot not found")
+	}
+	return s, nil
+}
+
+func diffSeatingLayout(from, to entities.SeatingSnapshotData) []*dto.SeatingChange {
+	changes := []*dto.SeatingChange{}
+	add := func(kind, id, name, change string, fields []string) {
+		changes = append(changes, &dto.SeatingChange{Kind: kind, ID: id, Name: name, Change: change, Fields: fields})
+	}
+
+	fromRooms := make(map[string]entities.EventRoom, len(from.Rooms))
+	for _, r := range from.Rooms {
+		fromRooms[r.ID] = r
+	}
+	toRooms := make(map[string]bool, len(to.Rooms))
+	for _, r := range to.Rooms {
+		toRooms[r.ID] = true
+		old, ok := fromRooms[r.ID]
+		if !ok {
+			add("room", r.ID, r.Name, "added", nil)
+			continue
+		}
+		var fields []string
+		fields = appendIf(fields, old.Name != r.Name, "name")
+		fields = appendIf(fields, old.Width != r.Width || old.Height != r.Height, "size")
+		fields = appendIf(fields, old.DisplayOrder != r.DisplayOrder, "display_order")
+		if len(fields) > 0 {
+			add("room", r.ID, r.Name, "changed", fields)
+		}
+	}
+	for _, r := range from.Rooms {
+		if !toRooms[r.ID] {
+			add("room", r.ID, r.Name, "removed", nil)
+		}
+	}
+
+	fromTables := make(map[string]entities.EventTable, len(from.Tables))
+	for _, t := range from.Tables {
+		fromTables[t.ID] = t
+	}
+	toTables := make(map[string]bool, len(to.Tables))
+	for _, t := range to.Tables {
+		toTables[t.ID] = true
+		old, ok := fromTables[t.ID]
+		if !ok {
+			add("table", t.ID, t.Name, "added", nil)
+			continue
+		}
+		var fields []string
+		fields = appendIf(fields, old.RoomID != t.RoomID, "room")
+		fields = appendIf(fields, old.Name != t.Name, "name")
+		fields = appendIf(fields, old.Shape != t.Shape, "shape")
+		fields = appendIf(fields, old.Capacity != t.Capacity, "capacity")
+		fields = appendIf(fields, old.SeatLabelScheme != t.SeatLabelScheme, "seat_label_scheme")
+		fields = appendIf(fields, old.PositionX != t.PositionX || old.PositionY != t.PositionY, "position")
+		fields = appendIf(fields, old.Width != t.Width || old.Height != t.Height, "size")
+		fields = appendIf(fields, old.Rotation != t.Rotation, "rotation")
+		fields = appendIf(fields, old.DisplayOrder != t.DisplayOrder, "display_order")
+		if len(fields) > 0 {
+			add("table", t.ID, t.Name, "changed", fields)
+		}
+	}
+	for _, t := range from.Tables {
+		if !toTables[t.ID] {
+			add("table", t.ID, t.Name, "removed", nil)
+		}
+	}
+
+	fromSeats := make(map[string]entities.EventSeat, len(from.Seats))
+	for _, s := range from.Seats {
+		fromSeats[s.ID] = s
+	}
+	toSeats := make(map[string]bool, len(to.Seats))
+	for _, s := range to.Seats {
+		toSeats[s.ID] = true
+		name := snapshotSeatName(to, s.ID)
+		old, ok := fromSeats[s.ID]
+		if !ok {
+			// Seats of a new table are implied by the table itself.
+			if _, tableExisted := fromTables[s.EventTableID]; tableExisted {
+				add("seat", s.ID, name, "added", nil)
+			}
+			continue
+		}
+		var fields []string
+		fields = appendIf(fields, old.Label != s.Label, "label")
+		fields = appendIf(fields, old.Accessible != s.Accessible, "accessible")
+		fields = appendIf(fields, old.VIP != s.VIP, "vip")
+		fields = appendIf(fields, old.Blocked != s.Blocked, "blocked")
+		fields = appendIf(fields, stringPtrValue(old.ReservedInviteID) != stringPtrValue(s.ReservedInviteID), "reserved_invite_id")
+		if len(fields) > 0 {
+			add("seat", s.ID, name, "changed", fields)
+		}
+	}
+	for _, s := range from.Seats {
+		if !toSeats[s.ID] && toTables[s.EventTableID] {
+			add("seat", s.ID, snapshotSeatName(from, s.ID), "removed", nil)
+		}
+	}
+
+	fromElements := make(map[string]entities.FloorElement, len(from.Elements))
+	for _, e := range from.Elements {
+		fromElements[e.ID] = e
+	}
+	toElements := make(map[string]bool, len(to.Elements))
+	for _, e := range to.Elements {
+		toElements[e.ID] = true
+		old, ok := fromElements[e.ID]
+		if !ok {
+			add("element", e.ID, e.Label, "added", nil)
+			continue
+		}
+		var fields []string
+		fields = appendIf(fields, old.RoomID != e.RoomID, "room")
+		fields = appendIf(fields, old.Kind != e.Kind, "kind")
+		fields = appendIf(fields, old.Label != e.Label, "label")
+		fields = appendIf(fields, old.PositionX != e.PositionX || old.PositionY != e.PositionY, "position")
+		fields = appendIf(fields, old.Width != e.Width || old.Height != e.Height, "size")
+		fields = appendIf(fields, old.Rotation != e.Rotation, "rotation")
+		if len(fields) > 0 {
+			add("element", e.ID, e.Label, "changed", fields)
+		}
+	}
+	for _, e := range from.Elements {
+		if !toElements[e.ID] {
+			add("element", e.ID, e.Label, "removed", nil)
+		}
+	}
+	return changes
+}
+
+func diffSeatingAssignments(from, to entities.SeatingSnapshotData, emails map[string]string) []*dto.SeatingAssignmentChange {
+	type occupant struct {
+		inviteID    string
+		companionID string // empty for the guest themself
+	}
+	key := func(a entities.EventSeatAssignment) occupant {
+		o := occupant{inviteID: a.InviteID}
+		if a.CompanionID != nil {
+			o.companionID = *a.CompanionID
+		}
+		return o
+	}
+	fromSeat := make(map[occupant]string, len(from.Assignments))
+	for _, a := range from.Assignments {
+		fromSeat[key(a)] = a.SeatID
+	}
+	toSeat := make(map[occupant]string, len(to.Assignments))
+	for _, a := range to.Assignments {
+		toSeat[key(a)] = a.SeatID
+	}
+	all := make([]occupant, 0, len(fromSeat)+len(toSeat))
+	for o := range fromSeat {
+		all = append(all, o)
+	}
+	for o := range toSeat {
+		if _, ok := fromSeat[o]; !ok {
+			all = append(all, o)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].inviteID != all[j].inviteID {
+			return all[i].inviteID < all[j].inviteID
+		}
+		return all[i].companionID < all[j].companionID
+	})
+	out := []*dto.SeatingAssignmentChange{}
+	for _, o := range all {
+		before, hadSeat := fromSeat[o]
+		after, hasSeat := toSeat[o]
+		if hadSeat && hasSeat && before == after {
+			continue
+		}
+		c := &dto.SeatingAssignmentChange{InviteID: o.inviteID, Email: emails[o.inviteID]}
+		if o.companionID != "" {
+			companionID := o.companionID
+			c.CompanionID = &companionID
+		}
+		if hadSeat {
+			c.FromSeatID = &before
+			c.FromSeat = snapshotSeatName(from, before)
+		}
+		if hasSeat {
+			c.ToSeatID = &after
+			c.ToSeat = snapshotSeatName(to, after)
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// snapshotSeatName returns "Table name / seat label" for a seat of the snapshot.
+func snapshotSeatName(data entities.SeatingSnapshotData, seatID string) string {
+	for _, s := range data.Seats {
+		if s.ID != seatID {
+			continue
+		}
+		for _, t := range data.Tables {
+			if t.ID == s.EventTableID {
+				return fmt.Sprintf("%s / %s", t.Name, s.Label)
+			}
+		}
+		return s.Label
+	}
+	return ""
+}
+
+func appendIf(fields []string, changed bool, field string) []string {
+	if changed {
+		return append(fields, field)
+	}
+	return fields
+}
+
+func stringPtrValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func toSeatingSnapshotResponse(s *entities.SeatingSnapshot) *dto.SeatingSnapshotResponse {
+	return &dto.SeatingSnapshotResponse{
+		ID:            s.ID,
+		EventID:       s.EventID,
+		Reason:        s.Reason,
+		CreatedBy:     s.CreatedBy,
+		RoomCount:     len(s.Data.Rooms),
+		TableCount:    len(s.Data.Tables),
+		SeatCount:     len(s.Data.Seats),
+		AssignedCount: len(s.Data.Assignments),
+		CreatedAt:     s.CreatedAt.Format(time.RFC3339),
+	}
+}
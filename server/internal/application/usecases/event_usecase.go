@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strconv"
 	"strings"
 	"time"
@@ -12,64 +13,124 @@ import (
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
 )
 
 type EventUseCase struct {
-	eventRepo       repositories.EventRepository
-	eventInviteRepo repositories.EventInviteRepository
-	eventTableRepo  repositories.EventTableRepository
-	eventSeatRepo   repositories.EventSeatRepository
-	userRepo        repositories.UserRepository
-	mailer          services.Mailer
+	eventRepo           repositories.EventRepository
+	eventInviteRepo     repositories.EventInviteRepository
+	eventRoomRepo       repositories.EventRoomRepository
+	eventTableRepo      repositories.EventTableRepository
+	eventSeatRepo       repositories.EventSeatRepository
+	floorElementRepo    repositories.FloorElementRepository
+	seatingSnapshotRepo repositories.SeatingSnapshotRepository
+	rsvpQuestionRepo    repositories.RSVPQuestionRepository
+	eventCheckInRepo    repositories.EventCheckInRepository
+	userRepo            repositories.UserRepository
+	transactor          repositories.Transactor
+	mailer              services.Mailer
+	seatingNotifier     services.SeatingNotifier
+	ticketSigner        services.TicketSigner
+	ticketRenderer      services.TicketRenderer
 }
 
 func NewEventUseCase(
 	eventRepo repositories.EventRepository,
 	eventInviteRepo repositories.EventInviteRepository,
+	eventRoomRepo repositories.EventRoomRepository,
 	eventTableRepo repositories.EventTableRepository,
 	eventSeatRepo repositories.EventSeatRepository,
+	floorElementRepo repositories.FloorElementRepository,
+	seatingSnapshotRepo repositories.SeatingSnapshotRepository,
+	rsvpQuestionRepo repositories.RSVPQuestionRepository,
+	eventCheckInRepo repositories.EventCheckInRepository,
 	userRepo repositories.UserRepository,
+	transactor repositories.Transactor,
 	mailer services.Mailer,
+	seatingNotifier services.SeatingNotifier,
+	ticketSigner services.TicketSigner,
+	ticketRenderer services.TicketRenderer,
 ) *EventUseCase {
 	if mailer == nil {
 		mailer = noOpMailer{}
 	}
+	if seatingNotifier == nil {
+		seatingNotifier = noOpSeatingNotifier{}
+	}
 	return &EventUseCase{
-		eventRepo:       eventRepo,
-		eventInviteRepo: eventInviteRepo,
-		eventTableRepo:  eventTableRepo,
-		eventSeatRepo:   eventSeatRepo,
-		userRepo:        userRepo,
-		mailer:          mailer,
+		eventRepo:           eventRepo,
+		eventInviteRepo:     eventInviteRepo,
+		eventRoomRepo:       eventRoomRepo,
+		eventTableRepo:      eventTableRepo,
+		eventSeatRepo:       eventSeatRepo,
+		floorElementRepo:    floorElementRepo,
+		seatingSnapshotRepo: seatingSnapshotRepo,
+		rsvpQuestionRepo:    rsvpQuestionRepo,
+		eventCheckInRepo:    eventCheckInRepo,
+		userRepo:            userRepo,
+		transactor:          transactor,
+		mailer:              mailer,
+		seatingNotifier:     seatingNotifier,
+		ticketSigner:        ticketSigner,
+		ticketRenderer:      ticketRenderer,
 	}
 }
 
 type noOpMailer struct{}
 
-func (noOpMailer) SendInviteEmail(ctx context.Context, toEmail, eventName, rsvpURL string) error {
+func (noOpMailer) SendInviteEmail(ctx context.Context, toEmail, eventName, rsvpURL string, invitation *services.CalendarPart) error {
+	return nil
+}
+
+func (noOpMailer) SendWaitlistPromotionEmail(ctx context.Context, toEmail, eventName, rsvpURL string) error {
+	return nil
+}
+
+func (noOpMailer) SendEventUpdatedEmail(ctx context.Context, toEmail, eventName, eventURL string, update *services.CalendarPart) error {
+	return nil
+}
+
+func (noOpMailer) SendEventCancelledEmail(ctx context.Context, toEmail, eventName string, cancellation *services.CalendarPart) error {
 	return nil
 }
 
+func (noOpMailer) SendRSVPDeclinedEmail(ctx context.Context, toEmail, eventName string, cancellation *services.CalendarPart) error {
+	return nil
+}
+
+type noOpSeatingNotifier struct{}
+
+func (noOpSeatingNotifier) NotifySeating(ctx context.Context, e services.SeatingEvent) {}
+
 func (uc *EventUseCase) toEventResponse(event *entities.Event) *dto.EventResponse {
 	if event == nil {
 		return nil
 	}
+	startsAt, endsAt := event.Schedule()
 	return &dto.EventResponse{
-		ID:         event.ID,
-		OwnerID:    event.OwnerID,
-		Name:       event.Name,
-		BannerURL:  event.BannerURL,
-		Visibility: string(event.Visibility),
-		EventType:  string(event.EventType),
-		Message:    event.Message,
-		EventDate:  event.EventDate.Format("2006-01-02"),
-		StartTime:  string(event.StartTime),
-		EndTime:    string(event.EndTime),
-		Location:   event.Location,
-		Latitude:   event.Latitude,
-		Longitude:  event.Longitude,
-		CreatedAt:  event.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:  event.UpdatedAt.Format(time.RFC3339),
+		ID:                    event.ID,
+		OwnerID:               event.OwnerID,
+		Name:                  event.Name,
+		BannerURL:             event.BannerURL,
+		Visibility:            string(event.Visibility),
+		EventType:             string(event.EventType),
+		Message:               event.Message,
+		EventDate:             event.EventDate.Format("2006-01-02"),
+		StartTime:             string(event.StartTime),
+		EndTime:               string(event.EndTime),
+		Location:              event.Location,
+		Latitude:              event.Latitude,
+		Longitude:             event.Longitude,
+		Timezone:              event.Timezone,
+		StartsAt:              startsAt.Format(time.RFC3339),
+		EndsAt:                endsAt.Format(time.RFC3339),
+		Capacity:              event.Capacity,
+		RSVPDeadline:          formatOptionalTime(event.RSVPDeadline),
+		SeatSelectionOpensAt:  formatOptionalTime(event.SeatSelectionOpensAt),
+		SeatSelectionClosesAt: formatOptionalTime(event.SeatSelectionClosesAt),
+		SeatingLocked:         event.SeatingLocked,
+		CreatedAt:             event.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:             event.UpdatedAt.Format(time.RFC3339),
 	}
 }
 
@@ -106,9 +167,14 @@ func (uc *EventUseCase) CreateEvent(ctx context.Context, ownerID string, req dto
 		Location:   req.Location,
 		Latitude:   req.Latitude,
 		Longitude:  req.Longitude,
+		Timezone:   eventTimezone(req.Timezone, "UTC"),
+		Capacity:   req.Capacity,
 		CreatedAt:  time.Now(),
 		UpdatedAt:  time.Now(),
 	}
+	if err := applyRSVPRules(event, req.RSVPDeadline, req.SeatSelectionOpensAt, req.SeatSelectionClosesAt, req.SeatingLocked); err != nil {
+		return nil, err
+	}
 
 	if err := event.Validate(); err != nil {
 		return nil, err
@@ -117,54 +183,92 @@ func (uc *EventUseCase) CreateEvent(ctx context.Context, ownerID string, req dto
 	if err := uc.eventRepo.Create(ctx, event); err != nil {
 		return nil, err
 	}
+	if _, err := uc.defaultRoom(ctx, event.ID); err != nil {
+		return nil, err
+	}
 
 	return uc.toEventResponse(event), nil
 }
 
+// UpdateEvent applies the edit to the event row as locked in the transaction, so the save does not undo
+// a change made since the organizer loaded the form, such as the seating lock. A nil SeatingLocked
+// leaves the lock as it is.
 func (uc *EventUseCase) UpdateEvent(ctx context.Context, ownerID string, req dto.UpdateEventRequest) (*dto.EventResponse, error) {
-	event, err := uc.eventRepo.FindByID(ctx, req.ID)
-	if err != nil {
-		return nil, err
-	}
-	if event.OwnerID != ownerID {
-		return nil, errors.New("you are not the owner of this event")
-	}
+	var event *entities.Event
+	var rescheduled bool
+	var promoted []*entities.EventInvite
+	err := uc.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		if event, err = uc.eventRepo.LockByID(ctx, req.ID); err != nil {
+			return err
+		}
+		if event.OwnerID != ownerID {
+			return errors.New("you are not the owner of this event")
+		}
 
-	eventDate, err := time.Parse("2006-01-02", req.EventDate)
-	if err != nil {
-		return nil, err
-	}
-	if _, err := time.Parse("15:04:05", req.StartTime); err != nil {
-		return nil, err
-	}
-	if _, err := time.Parse("15:04:05", req.EndTime); err != nil {
-		return nil, err
-	}
+		eventDate, err := time.Parse("2006-01-02", req.EventDate)
+		if err != nil {
+			return err
+		}
+		if _, err := time.Parse("15:04:05", req.StartTime); err != nil {
+			return err
+		}
+		if _, err := time.Parse("15:04:05", req.EndTime); err != nil {
+			return err
+		}
+		timezone := eventTimezone(req.Timezone, event.Timezone)
+		// Guests who are coming get a calendar update when the date, time or place changes.
+		rescheduled = event.EventDate.Format("2006-01-02") != eventDate.Format("2006-01-02") ||
+			string(event.StartTime) != req.StartTime || string(event.EndTime) != req.EndTime || event.Timezone != timezone ||
+			event.Location != req.Location || event.Latitude != req.Latitude || event.Longitude != req.Longitude
+		if rescheduled {
+			event.CalendarSequence++
+		}
 
-	event.Name = req.Name
-	event.BannerURL = req.BannerURL
-	event.Visibility = entities.Visibility(req.Visibility)
-	event.EventType = entities.EventType(req.EventType)
-	event.Message = req.Message
-	event.EventDate = eventDate
-	event.StartTime = entities.TimeOfDay(req.StartTime)
-	event.EndTime = entities.TimeOfDay(req.EndTime)
-	event.Location = req.Location
-	event.Latitude = req.Latitude
-	event.Longitude = req.Longitude
-	event.UpdatedAt = time.Now()
+		event.Name = req.Name
+		event.BannerURL = req.BannerURL
+		event.Visibility = entities.Visibility(req.Visibility)
+		event.EventType = entities.EventType(req.EventType)
+		event.Message = req.Message
+		event.EventDate = eventDate
+		event.StartTime = entities.TimeOfDay(req.StartTime)
+		event.EndTime = entities.TimeOfDay(req.EndTime)
+		event.Timezone = timezone
+		event.Location = req.Location
+		event.Latitude = req.Latitude
+		event.Longitude = req.Longitude
+		event.Capacity = req.Capacity
+		locked := event.SeatingLocked
+		if req.SeatingLocked != nil {
+			locked = *req.SeatingLocked
+		}
+		if err := applyRSVPRules(event, req.RSVPDeadline, req.SeatSelectionOpensAt, req.SeatSelectionClosesAt, locked); err != nil {
+			return err
+		}
+		event.UpdatedAt = time.Now()
 
-	if err := event.Validate(); err != nil {
+		if err := event.Validate(); err != nil {
+			return err
+		}
+		if err := uc.eventRepo.Update(ctx, event); err != nil {
+			return err
+		}
+		// Raising or removing the capacity lets the waitlist in; lowering it never un-confirms anyone.
+		promoted, err = uc.promoteWaitlist(ctx, event)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-
-	if err := uc.eventRepo.Update(ctx, event); err != nil {
-		return nil, err
+	uc.notifyPromoted(ctx, event, promoted)
+	if rescheduled {
+		uc.notifyRescheduled(ctx, event)
 	}
 
 	return uc.toEventResponse(event), nil
 }
 
+// DeleteEvent deletes the event and sends a calendar cancellation to every guest who has not declined it.
 func (uc *EventUseCase) DeleteEvent(ctx context.Context, id string, ownerID string) error {
 	event, err := uc.eventRepo.FindByID(ctx, id)
 	if err != nil {
@@ -173,7 +277,15 @@ func (uc *EventUseCase) DeleteEvent(ctx context.Context, id string, ownerID stri
 	if event.OwnerID != ownerID {
 		return errors.New("you are not the owner of this event")
 	}
-	return uc.eventRepo.Delete(ctx, event)
+	invites, err := uc.eventInviteRepo.ListByEventID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.eventRepo.Delete(ctx, event); err != nil {
+		return err
+	}
+	uc.notifyCancelled(ctx, event, invites)
+	return nil
 }
 
 func (uc *EventUseCase) GetEvent(ctx context.Context, id string, callerID string) (*dto.EventResponse, error) {
@@ -285,9 +397,16 @@ func (uc *EventUseCase) invitesToInvitationResponses(ctx context.Context, invite
 	return out
 }
 
-// RespondToInvite updates the current user's RSVP status for an event (confirmed or declined). Optionally assigns seat(s) when confirming (guest_seat_id for plus-one).
+// RespondToInvite updates the current user's RSVP status for an event (confirmed or declined). Optionally assigns a seat when confirming.
+// A non-nil companions list replaces the guest's companions (up to the invite's party size) and may seat them; nil keeps them.
+// Answers to the event's RSVP questions are checked and saved when confirming; see collectRSVPAnswers.
 // For public events, if the user has no invite yet, one is created so they can RSVP.
-func (uc *EventUseCase) RespondToInvite(ctx context.Context, userID string, eventID string, status string, seatID *string, guestSeatID *string) (*dto.EventInviteResponse, error) {
+// Seats are claimed in a transaction; if another guest already has one of them, errors.ErrSeatTaken is returned,
+// and errors.ErrSeatHeld if someone else is currently holding it.
+// When the event has a capacity, a confirmation that does not fit (or arrives while others are waiting) is
+// put on the waitlist with status "waitlisted" and no seats. A decline promotes the head of the waitlist.
+func (uc *EventUseCase) RespondToInvite(ctx context.Context, userID string, eventID string, req dto.RespondToInviteRequest) (*dto.EventInviteResponse, error) {
+	status, seatID := req.Status, req.SeatID
 	if status != "confirmed" && status != "declined" {
 		return nil, errors.New("status must be confirmed or declined")
 	}
@@ -295,120 +414,263 @@ func (uc *EventUseCase) RespondToInvite(ctx context.Context, userID string, even
 	if err != nil {
 		return nil, errors.New("event not found")
 	}
-	now := time.Now().UTC()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-	eventDay := time.Date(event.EventDate.Year(), event.EventDate.Month(), event.EventDate.Day(), 0, 0, 0, 0, time.UTC)
-	if eventDay.Before(today) {
+	if eventHasPassed(event) {
 		return nil, errors.New("cannot RSVP for an event that has already passed")
 	}
-	invite, err := uc.eventInviteRepo.FindByEventAndUser(ctx, eventID, userID)
-	if err != nil {
-		user, errUser := uc.userRepo.FindByID(ctx, userID)
-		if errUser != nil {
-			return nil, errors.New("user not found")
+	var invite *entities.EventInvite
+	var previous string
+	var before map[string]string
+	var promoted []*entities.EventInvite
+	var answers []partyAnswer
+	err = uc.withSeatingSnapshot(ctx, eventID, userID, snapshotRSVP, func(ctx context.Context) error {
+		// Capacity, deadlines and the seating lock are read from the locked row, not the copy loaded above.
+		if event, err = uc.eventRepo.LockByID(ctx, eventID); err != nil {
+			return err
 		}
-		inviteByEmail, errEmail := uc.eventInviteRepo.FindByEventAndEmail(ctx, eventID, user.Email)
-		if errEmail == nil && inviteByEmail != nil {
-			invite = inviteByEmail
-			if invite.UserID == nil {
-				invite.UserID = &userID
-				_ = uc.eventInviteRepo.Update(ctx, invite)
-			}
-			// fall through to update status/seat
+		before = uc.seatToInviteMap(ctx, eventID)
+		var isNew bool
+		invite, isNew, err = uc.findOrCreateRSVPInvite(ctx, event, userID, status)
+		if err != nil {
+			return err
+		}
+		previous = invite.Status
+		if isNew {
+			previous = ""
+		}
+		now := time.Now()
+		if err := checkRSVPOpen(event, previous, status, now); err != nil {
+			return err
+		}
+		oldSeats := partySeats(invite)
+		invite.Status = status
+		if status == "declined" {
+			clearPartySeats(invite)
+			invite.WaitlistedAt = nil
 		} else {
-			if event.Visibility != entities.VisibilityPublic {
-				return nil, errors.New("invitation not found")
-			}
-			invite = &entities.EventInvite{
-				EventID:   eventID,
-				UserID:    &userID,
-				Email:     user.Email,
-				Status:    status,
-				CreatedAt: time.Now(),
-				UpdatedAt: time.Now(),
-			}
 			if seatID != nil && *seatID != "" {
-				seat, errSeat := uc.eventSeatRepo.FindByID(ctx, *seatID)
-				if errSeat == nil {
-					table, _ := uc.eventTableRepo.FindByID(ctx, seat.EventTableID)
-					if table != nil && table.EventID == eventID {
-						invite.SeatID = seatID
-					}
+				seat, err := uc.findEventSeat(ctx, eventID, *seatID)
+				if err != nil {
+					return err
 				}
+				if err := checkSeatSelectable(seat, invite.ID); err != nil {
+					return err
+				}
+				invite.SeatID = seatID
 			}
-			if guestSeatID != nil && *guestSeatID != "" {
-				seat, errSeat := uc.eventSeatRepo.FindByID(ctx, *guestSeatID)
-				if errSeat == nil {
-					table, _ := uc.eventTableRepo.FindByID(ctx, seat.EventTableID)
-					if table != nil && table.EventID == eventID {
-						invite.GuestSeatID = guestSeatID
-					}
+			if req.Companions != nil {
+				if err := uc.applyCompanions(ctx, eventID, invite, req.Companions); err != nil {
+					return err
 				}
 			}
-			if errCreate := uc.eventInviteRepo.Create(ctx, invite); errCreate != nil {
-				return nil, errCreate
+			if answers, err = uc.collectRSVPAnswers(ctx, eventID, invite, isNew, req); err != nil {
+				return err
 			}
-			return uc.toEventInviteResponse(invite), nil
-		}
-	}
-	invite.Status = status
-	if status == "declined" {
-		invite.SeatID = nil
-		invite.GuestSeatID = nil
-	} else {
-		if seatID != nil && *seatID != "" {
-			seat, err := uc.eventSeatRepo.FindByID(ctx, *seatID)
-			if err != nil {
-				return nil, errors.New("seat not found")
+			if err := checkPartySeatsDistinct(invite); err != nil {
+				return err
+			}
+			if !maps.Equal(oldSeats, partySeats(invite)) {
+				if err := checkSeatSelectionOpen(event, now); err != nil {
+					return err
+				}
 			}
-			table, err := uc.eventTableRepo.FindByID(ctx, seat.EventTableID)
-			if err != nil || table.EventID != eventID {
-				return nil, errors.New("seat does not belong to this event")
+			if err := uc.admitOrWaitlist(ctx, event, invite, previous); err != nil {
+				return err
 			}
-			invite.SeatID = seatID
 		}
-		if guestSeatID != nil && *guestSeatID != "" {
-			seat, err := uc.eventSeatRepo.FindByID(ctx, *guestSeatID)
-			if err != nil {
-				return nil, errors.New("guest seat not found")
+		claimed := inviteSeatIDs(invite)
+		if err := uc.eventSeatRepo.LockByIDs(ctx, claimed); err != nil {
+			return err
+		}
+		if err := uc.checkSeatHolds(ctx, eventID, userID, claimed, time.Now()); err != nil {
+			return err
+		}
+		invite.UpdatedAt = time.Now()
+		if isNew {
+			if err := uc.eventInviteRepo.Create(ctx, invite); err != nil {
+				return err
 			}
-			table, err := uc.eventTableRepo.FindByID(ctx, seat.EventTableID)
-			if err != nil || table.EventID != eventID {
-				return nil, errors.New("guest seat does not belong to this event")
+		} else if err := uc.eventInviteRepo.Update(ctx, invite); err != nil {
+			return err
+		}
+		if err := uc.syncSeatAssignments(ctx, invite); err != nil {
+			return err
+		}
+		if status == "confirmed" && answers != nil {
+			if err := uc.saveRSVPAnswers(ctx, invite, answers); err != nil {
+				return err
 			}
-			if seatID != nil && guestSeatID != nil && *guestSeatID == *seatID {
-				return nil, errors.New("primary and guest seat must be different")
+		}
+		if previous == "confirmed" {
+			// A decline, or a confirmed guest dropping companions, may free a spot for the waitlist.
+			if promoted, err = uc.promoteWaitlist(ctx, event); err != nil {
+				return err
 			}
-			invite.GuestSeatID = guestSeatID
-		} else {
-			invite.GuestSeatID = nil
 		}
-	}
-	invite.UpdatedAt = time.Now()
-	if err := uc.eventInviteRepo.Update(ctx, invite); err != nil {
+		// The RSVP consumes (or, on decline, gives up) whatever the guest was holding.
+		return uc.eventSeatRepo.DeleteUserHolds(ctx, eventID, userID)
+	})
+	if err != nil {
 		return nil, err
 	}
+	uc.notifySeatChanges(ctx, eventID, before)
+	uc.notifyPromoted(ctx, event, promoted)
+	if status == "declined" && previous != "declined" {
+		uc.notifyDeclined(ctx, event, invite, previous)
+	}
 	return uc.toEventInviteResponse(invite), nil
 }
 
+// findOwnedEvent loads the event and checks that ownerID owns it.
+func (uc *EventUseCase) findOwnedEvent(ctx context.Context, ownerID, eventID string) (*entities.Event, error) {
+	event, err := uc.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.OwnerID != ownerID {
+		return nil, errors.New("you are not the owner of this event")
+	}
+	return event, nil
+}
+
+// eventHasPassed reports whether the event has ended, in its own time zone.
+func eventHasPassed(event *entities.Event) bool {
+	return event.HasEnded(time.Now())
+}
+
+// eventTimezone returns the requested IANA time zone, or fallback when the request leaves it out.
+// Event.Validate rejects unknown names.
+func eventTimezone(requested, fallback string) string {
+	if tz := strings.TrimSpace(requested); tz != "" {
+		return tz
+	}
+	return fallback
+}
+
+// inviteSeatIDs returns the seats the invite's party currently points at, the guest's first.
+func inviteSeatIDs(invite *entities.EventInvite) []string {
+	var ids []string
+	if invite.SeatID != nil && *invite.SeatID != "" {
+		ids = append(ids, *invite.SeatID)
+	}
+	for _, c := range invite.Companions {
+		if c.SeatID != nil && *c.SeatID != "" {
+			ids = append(ids, *c.SeatID)
+		}
+	}
+	return ids
+}
+
+// findOrCreateRSVPInvite returns the caller's invite for the event, matching by user and then by email
+// (linking the account to an email-only invite). For public events without an invite, a new unsaved
+// invite is returned with isNew set.
+func (uc *EventUseCase) findOrCreateRSVPInvite(ctx context.Context, event *entities.Event, userID, status string) (invite *entities.EventInvite, isNew bool, err error) {
+	invite, err = uc.eventInviteRepo.FindByEventAndUser(ctx, event.ID, userID)
+	if err == nil {
+		return invite, false, nil
+	}
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, false, errors.New("user not found")
+	}
+	invite, err = uc.eventInviteRepo.FindByEventAndEmail(ctx, event.ID, user.Email)
+	if err == nil && invite != nil {
+		if invite.UserID == nil {
+			invite.UserID = &userID
+		}
+		return invite, false, nil
+	}
+	if event.Visibility != entities.VisibilityPublic {
+		return nil, false, errors.New("invitation not found")
+	}
+	return &entities.EventInvite{
+		EventID:   event.ID,
+		UserID:    &userID,
+		Email:     user.Email,
+		Status:    status,
+		PartySize: entities.DefaultPartySize,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, true, nil
+}
+
+// findEventSeat loads a seat and checks that its table belongs to the event.
+func (uc *EventUseCase) findEventSeat(ctx context.Context, eventID, seatID string) (*entities.EventSeat, error) {
+	seat, err := uc.eventSeatRepo.FindByID(ctx, seatID)
+	if err != nil {
+		return nil, errors.New("seat not found")
+	}
+	table, err := uc.eventTableRepo.FindByID(ctx, seat.EventTableID)
+	if err != nil || table.EventID != eventID {
+		return nil, errors.New("seat does not belong to this event")
+	}
+	return seat, nil
+}
+
+// checkSeatSelectable rejects seats a guest may not pick: blocked seats and seats reserved for
+// another invite. inviteID is empty for a guest who has no invite yet.
+func checkSeatSelectable(seat *entities.EventSeat, inviteID string) error {
+	if seat.Blocked {
+		return apperrors.ErrSeatBlocked
+	}
+	if seat.ReservedInviteID != nil && *seat.ReservedInviteID != inviteID {
+		return apperrors.ErrSeatReserved
+	}
+	return nil
+}
+
+// syncSeatAssignments makes the invite's seat assignment rows match the seats of the guest and their companions.
+// Call it inside a transaction after the invite has been saved.
+func (uc *EventUseCase) syncSeatAssignments(ctx context.Context, invite *entities.EventInvite) error {
+	var assignments []*entities.EventSeatAssignment
+	if invite.SeatID != nil && *invite.SeatID != "" {
+		assignments = append(assignments, &entities.EventSeatAssignment{
+			EventID:   invite.EventID,
+			SeatID:    *invite.SeatID,
+			CreatedAt: time.Now(),
+		})
+	}
+	for _, c := range invite.Companions {
+		if c.SeatID == nil || *c.SeatID == "" {
+			continue
+		}
+		companionID := c.ID
+		assignments = append(assignments, &entities.EventSeatAssignment{
+			EventID:     invite.EventID,
+			SeatID:      *c.SeatID,
+			CompanionID: &companionID,
+			CreatedAt:   time.Now(),
+		})
+	}
+	return uc.eventSeatRepo.ReplaceInviteAssignments(ctx, invite.ID, assignments)
+}
+
 func (uc *EventUseCase) toEventInviteResponse(inv *entities.EventInvite) *dto.EventInviteResponse {
 	var userID string
 	if inv.UserID != nil {
 		userID = *inv.UserID
 	}
-	return &dto.EventInviteResponse{
-		ID:          inv.ID,
-		EventID:     inv.EventID,
-		UserID:      userID,
-		Email:       inv.Email,
-		Status:      inv.Status,
-		SeatID:      inv.SeatID,
-		GuestSeatID: inv.GuestSeatID,
-		CreatedAt:   inv.CreatedAt.Format(time.RFC3339),
+	resp := &dto.EventInviteResponse{
+		ID:         inv.ID,
+		EventID:    inv.EventID,
+		UserID:     userID,
+		Email:      inv.Email,
+		Status:     inv.Status,
+		SeatID:     inv.SeatID,
+		PartySize:  inv.PartySize,
+		Companions: make([]*dto.CompanionResponse, len(inv.Companions)),
+		CreatedAt:  inv.CreatedAt.Format(time.RFC3339),
+	}
+	for i, c := range inv.Companions {
+		resp.Companions[i] = &dto.CompanionResponse{ID: c.ID, Name: c.Name, SeatID: c.SeatID}
+	}
+	if inv.WaitlistedAt != nil {
+		resp.WaitlistedAt = inv.WaitlistedAt.Format(time.RFC3339)
 	}
+	return resp
 }
 
-// GetTicketData returns ticket data for a confirmed guest (for QR ticket download).
+// GetTicketData returns ticket data for a confirmed guest (for QR ticket download), with a signed token
+// door staff can verify.
 func (uc *EventUseCase) GetTicketData(ctx context.Context, eventID, userID string) (*dto.TicketResponse, error) {
 	event, err := uc.eventRepo.FindByID(ctx, eventID)
 	if err != nil {
@@ -432,6 +694,35 @@ func (uc *EventUseCase) GetTicketData(ctx context.Context, eventID, userID strin
 	if guestName == "" {
 		guestName = user.Email
 	}
+	return uc.buildTicket(ctx, event, invite, guestName)
+}
+
+// GetInviteTicketData returns a confirmed guest's ticket to the organizer, e.g. to send it on. Owner only.
+func (uc *EventUseCase) GetInviteTicketData(ctx context.Context, ownerID, eventID, inviteID string) (*dto.TicketResponse, error) {
+	event, err := uc.findOwnedEvent(ctx, ownerID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	invite, err := uc.eventInviteRepo.FindByID(ctx, inviteID)
+	if err != nil || invite.EventID != eventID {
+		return nil, errors.New("you are not invited to this event")
+	}
+	if invite.Status != "confirmed" {
+		return nil, errors.New("ticket is only available after you confirm your RSVP")
+	}
+	guestName := invite.Email
+	if invite.UserID != nil {
+		if user, err := uc.userRepo.FindByID(ctx, *invite.UserID); err == nil {
+			if name := strings.TrimSpace(user.FirstName + " " + user.LastName); name != "" {
+				guestName = name
+			}
+		}
+	}
+	return uc.buildTicket(ctx, event, invite, guestName)
+}
+
+// buildTicket signs a ticket for the invite and lists where each member of the party sits.
+func (uc *EventUseCase) buildTicket(ctx context.Context, event *entities.Event, invite *entities.EventInvite, guestName string) (*dto.TicketResponse, error) {
 	startTime := string(event.StartTime)
 	endTime := string(event.EndTime)
 	if len(startTime) >= 5 {
@@ -440,15 +731,77 @@ func (uc *EventUseCase) GetTicketData(ctx context.Context, eventID, userID strin
 	if len(endTime) >= 5 {
 		endTime = endTime[:5]
 	}
-	return &dto.TicketResponse{
+	now := time.Now().UTC()
+	expiresAt := ticketExpiresAt(event)
+	token, err := uc.ticketSigner.Sign(services.TicketClaims{
+		EventID:   event.ID,
+		InviteID:  invite.ID,
+		PartySize: inviteHeads(invite),
+		IssuedAt:  now,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &dto.TicketResponse{
 		TicketID:  invite.ID,
+		Token:     token,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
 		GuestName: guestName,
 		EventName: event.Name,
 		EventDate: event.EventDate.Format("2006-01-02"),
 		StartTime: startTime,
 		EndTime:   endTime,
 		Location:  event.Location,
-	}, nil
+		BannerURL: event.BannerURL,
+		Seats:     []*dto.TicketSeatResponse{uc.ticketSeat(ctx, guestName, invite.SeatID)},
+	}
+	for _, c := range invite.Companions {
+		resp.Seats = append(resp.Seats, uc.ticketSeat(ctx, c.Name, c.SeatID))
+	}
+	return resp, nil
+}
+
+// ticketSeat names the table and seat of one party member; both are empty while they have no seat.
+func (uc *EventUseCase) ticketSeat(ctx context.Context, name string, seatID *string) *dto.TicketSeatResponse {
+	out := &dto.TicketSeatResponse{Name: name}
+	if seatID == nil || *seatID == "" {
+		return out
+	}
+	seat, err := uc.eventSeatRepo.FindByID(ctx, *seatID)
+	if err != nil {
+		return out
+	}
+	out.Seat = seat.Label
+	if table, err := uc.eventTableRepo.FindByID(ctx, seat.EventTableID); err == nil {
+		out.Table = table.Name
+	}
+	return out
+}
+
+// RenderTicket draws the ticket as a PNG image or a PDF document.
+func (uc *EventUseCase) RenderTicket(ticket *dto.TicketResponse, format string) ([]byte, error) {
+	doc := services.TicketDocument{
+		Token:     ticket.Token,
+		TicketID:  ticket.TicketID,
+		GuestName: ticket.GuestName,
+		EventName: ticket.EventName,
+		EventDate: ticket.EventDate,
+		StartTime: ticket.StartTime,
+		EndTime:   ticket.EndTime,
+		Location:  ticket.Location,
+		BannerURL: ticket.BannerURL,
+	}
+	for _, seat := range ticket.Seats {
+		doc.Seats = append(doc.Seats, services.TicketDocumentSeat{Name: seat.Name, Table: seat.Table, Seat: seat.Seat})
+	}
+	switch format {
+	case "png":
+		return uc.ticketRenderer.RenderPNG(doc)
+	case "pdf":
+		return uc.ticketRenderer.RenderPDF(doc)
+	}
+	return nil, fmt.Errorf("unsupported ticket format %q", format)
 }
 
 // ListPublicEvents returns public events for discovery (no auth). search is optional; limit/offset for pagination.
@@ -471,7 +824,8 @@ func (uc *EventUseCase) ListPublicEvents(ctx context.Context, search string, lim
 }
 
 // InviteUserToEvent invites a guest (by email) to an event. Email may belong to an existing user or not; if not, they receive the invite by email and can RSVP after signing up.
-func (uc *EventUseCase) InviteUserToEvent(ctx context.Context, ownerID, eventID string, email string) (*dto.EventInviteResponse, error) {
+// partySize is how many people the invite stands for (the guest and their companions); zero means entities.DefaultPartySize.
+func (uc *EventUseCase) InviteUserToEvent(ctx context.Context, ownerID, eventID string, email string, partySize int) (*dto.EventInviteResponse, error) {
 	event, err := uc.eventRepo.FindByID(ctx, eventID)
 	if err != nil {
 		return nil, err
@@ -483,6 +837,9 @@ func (uc *EventUseCase) InviteUserToEvent(ctx context.Context, ownerID, eventID
 		return nil, errors.New("email is required")
 	}
 	email = strings.TrimSpace(strings.ToLower(email))
+	if partySize == 0 {
+		partySize = entities.DefaultPartySize
+	}
 
 	existsByEmail, err := uc.eventInviteRepo.ExistsByEventAndEmail(ctx, eventID, email)
 	if err != nil {
@@ -500,21 +857,25 @@ func (uc *EventUseCase) InviteUserToEvent(ctx context.Context, ownerID, eventID
 			return nil, errors.New("user is already invited to this event")
 		}
 		invite = &entities.EventInvite{
-			EventID:   eventID,
-			UserID:    &user.ID,
-			Email:     user.Email,
-			Status:    "pending",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+			EventID:         eventID,
+			UserID:          &user.ID,
+			Email:           user.Email,
+			Status:          "pending",
+			PartySize:       partySize,
+			CalendarInvited: true,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
 		}
 	} else {
 		invite = &entities.EventInvite{
-			EventID:   eventID,
-			UserID:    nil,
-			Email:     email,
-			Status:    "pending",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+			EventID:         eventID,
+			UserID:          nil,
+			Email:           email,
+			Status:          "pending",
+			PartySize:       partySize,
+			CalendarInvited: true,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
 		}
 	}
 	if err := invite.Validate(); err != nil {
@@ -525,7 +886,7 @@ func (uc *EventUseCase) InviteUserToEvent(ctx context.Context, ownerID, eventID
 	}
 
 	rsvpPath := fmt.Sprintf("/events/%s/rsvp", eventID)
-	_ = uc.mailer.SendInviteEmail(ctx, invite.Email, event.Name, rsvpPath)
+	_ = uc.mailer.SendInviteEmail(ctx, invite.Email, event.Name, rsvpPath, calendarMessage(event, uc.calendarOrganizer(ctx, event), "REQUEST", invite))
 
 	return uc.toEventInviteResponse(invite), nil
 }
@@ -576,8 +937,9 @@ func (uc *EventUseCase) ListEventInvitesPaginated(ctx context.Context, ownerID,
 	return &dto.PaginatedInvitesResponse{Items: out, Total: total}, nil
 }
 
-// CreateEventTable creates a table and capacity seats for an event. Owner only.
-func (uc *EventUseCase) CreateEventTable(ctx context.Context, ownerID, eventID string, req dto.CreateEventTableRequest) (*dto.EventTableResponse, error) {
+// CreateEventTable creates a table and capacity seats in a room of the event; an empty roomID means the
+// event's first room. Owner only.
+func (uc *EventUseCase) CreateEventTable(ctx context.Context, ownerID, eventID, roomID string, req dto.CreateEventTableRequest) (*dto.EventTableResponse, error) {
 	event, err := uc.eventRepo.FindByID(ctx, eventID)
 	if err != nil {
 		return nil, err
@@ -585,6 +947,10 @@ func (uc *EventUseCase) CreateEventTable(ctx context.Context, ownerID, eventID s
 	if event.OwnerID != ownerID {
 		return nil, errors.New("you are not the owner of this event")
 	}
+	room, err := uc.resolveRoom(ctx, eventID, roomID)
+	if err != nil {
+		return nil, err
+	}
 	shape := req.Shape
 	if shape != "rectangular" && shape != "grid" {
 		shape = "round"
@@ -608,7 +974,8 @@ func (uc *EventUseCase) CreateEventTable(ctx context.Context, ownerID, eventID s
 		capacity = req.Capacity
 	}
 	tables, _ := uc.eventTableRepo.ListByEventID(ctx, eventID)
-	displayOrder := len(tables)
+	roomTables := tablesInRoom(tables, room.ID)
+	displayOrder := len(roomTables)
 	var tableNumber, sittingAreaNumber int
 	for _, tbl := range tables {
 		if tbl.Shape == "grid" {
@@ -617,17 +984,36 @@ func (uc *EventUseCase) CreateEventTable(ctx context.Context, ownerID, eventID s
 			tableNumber++
 		}
 	}
-	var name string
-	if shape == "grid" {
+	name, err := normalizeTableName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" && shape == "grid" {
 		name = "Sitting area " + strconv.Itoa(sittingAreaNumber+1)
-	} else {
+	} else if name == "" {
 		name = "Table " + strconv.Itoa(tableNumber+1)
 	}
-	// Default position: stagger new tables/sitting areas on the floor
+	width, height := defaultTableSize(shape, tableRows, tableCols)
+	if req.Width != nil {
+		width = *req.Width
+	}
+	if req.Height != nil {
+		height = *req.Height
+	}
+	if width <= 0 || width > 100 || height <= 0 || height > 100 {
+		return nil, errors.New("width and height must be between 0 and 100")
+	}
+	rotation := normalizeRotation(req.Rotation)
+	// Default position: stagger new tables/sitting areas on the floor, moved to the nearest free spot
 	posX := 20.0 + float64(displayOrder%4)*22.0
 	posY := 25.0 + float64(displayOrder/4)*25.0
+	posX, posY, ok := nearestFreePosition(posX, posY, width, height, rotation, placedTableFootprints(roomTables, ""))
+	if !ok {
+		return nil, &TablePlacementError{Err: apperrors.ErrTableOverlap}
+	}
 	t := &entities.EventTable{
 		EventID:      eventID,
+		RoomID:       room.ID,
 		Name:         name,
 		Shape:        shape,
 		TableRows:    tableRows,
@@ -636,82 +1022,163 @@ func (uc *EventUseCase) CreateEventTable(ctx context.Context, ownerID, eventID s
 		DisplayOrder: displayOrder,
 		PositionX:    posX,
 		PositionY:    posY,
+		Width:        width,
+		Height:       height,
+		Rotation:     rotation,
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
 	}
-	if err := uc.eventTableRepo.Create(ctx, t); err != nil {
+	if err := applySeatLabelScheme(t, req.SeatLabelScheme, req.SeatLabels); err != nil {
 		return nil, err
 	}
 	seats := make([]*entities.EventSeat, capacity)
-	for i := 0; i < capacity; i++ {
-		seats[i] = &entities.EventSeat{
-			EventTableID: t.ID,
-			Label:        strconv.Itoa(i + 1),
-			DisplayOrder: i,
-			CreatedAt:    time.Now(),
-			UpdatedAt:    time.Now(),
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotTableCreated, func(ctx context.Context) error {
+		if err := uc.eventTableRepo.Create(ctx, t); err != nil {
+			return err
 		}
-	}
-	if err := uc.eventSeatRepo.CreateBulk(ctx, seats); err != nil {
-		_ = uc.eventTableRepo.Delete(ctx, t)
+		for i := 0; i < capacity; i++ {
+			seats[i] = &entities.EventSeat{
+				EventTableID: t.ID,
+				Label:        seatLabel(t, i),
+				DisplayOrder: i,
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
+			}
+		}
+		return uc.eventSeatRepo.CreateBulk(ctx, seats)
+	})
+	if err != nil {
 		return nil, err
 	}
-	return uc.buildEventTableResponse(ctx, t, seats, nil), nil
+	resp := uc.buildEventTableResponse(ctx, t, seats, nil, nil)
+	uc.seatingNotifier.NotifySeating(ctx, services.SeatingEvent{
+		Type:    services.SeatingTableAdded,
+		EventID: eventID,
+		TableID: t.ID,
+		Data:    resp,
+	})
+	return resp, nil
 }
 
-// ListEventSeating returns tables with seats and which invite (if any) is assigned to each seat. Caller must be owner or invited guest.
-func (uc *EventUseCase) ListEventSeating(ctx context.Context, eventID string, callerID string) ([]*dto.EventTableResponse, error) {
-	event, err := uc.eventRepo.FindByID(ctx, eventID)
-	if err != nil {
-		return nil, err
+// ListEventSeating returns the event's rooms, tables with seats and which invite (if any) is assigned to
+// each seat, plus the floor elements (stage, bar, ...) of every room. Caller must be owner or invited guest.
+func (uc *EventUseCase) ListEventSeating(ctx context.Context, eventID string, callerID string) (*dto.EventSeatingResponse, error) {
+	return uc.listSeating(ctx, eventID, "", callerID)
+}
+
+// ListRoomSeating is ListEventSeating limited to one room; Rooms only contains that room.
+func (uc *EventUseCase) ListRoomSeating(ctx context.Context, eventID, roomID, callerID string) (*dto.EventSeatingResponse, error) {
+	if roomID == "" {
+		return nil, errors.New("room not found")
 	}
-	canAccess := event.OwnerID == callerID || event.Visibility == entities.VisibilityPublic
-	if !canAccess && callerID != "" {
-		invited, _ := uc.eventInviteRepo.ExistsByEventAndUser(ctx, eventID, callerID)
-		canAccess = invited
+	return uc.listSeating(ctx, eventID, roomID, callerID)
+}
+
+func (uc *EventUseCase) listSeating(ctx context.Context, eventID, roomID, callerID string) (*dto.EventSeatingResponse, error) {
+	if _, err := uc.checkSeatingAccess(ctx, eventID, callerID); err != nil {
+		return nil, err
 	}
-	if !canAccess {
-		return nil, errors.New("forbidden: you do not have access to this event")
+	rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
 	}
 	tables, err := uc.eventTableRepo.ListByEventID(ctx, eventID)
 	if err != nil {
 		return nil, err
 	}
-	invites, _ := uc.eventInviteRepo.ListByEventID(ctx, eventID)
-	seatToInvite := make(map[string]string)
-	for _, inv := range invites {
-		if inv.SeatID != nil {
-			seatToInvite[*inv.SeatID] = inv.ID
-		}
-		if inv.GuestSeatID != nil {
-			seatToInvite[*inv.GuestSeatID] = inv.ID
+	elements, err := uc.floorElementRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	seatToInvite := uc.seatToInviteMap(ctx, eventID)
+	seatHolds := uc.seatHoldMap(ctx, eventID)
+	out := &dto.EventSeatingResponse{
+		Rooms:    []*dto.EventRoomResponse{},
+		Tables:   make([]*dto.EventTableResponse, 0, len(tables)),
+		Elements: []*dto.FloorElementResponse{},
+	}
+	for _, room := range rooms {
+		if roomID == "" || room.ID == roomID {
+			out.Rooms = append(out.Rooms, toEventRoomResponse(room, len(tablesInRoom(tables, room.ID))))
 		}
 	}
-	out := make([]*dto.EventTableResponse, 0, len(tables))
+	if roomID != "" && len(out.Rooms) == 0 {
+		return nil, errors.New("room not found")
+	}
 	for _, t := range tables {
+		if roomID != "" && t.RoomID != roomID {
+			continue
+		}
 		seats, _ := uc.eventSeatRepo.ListByEventTableID(ctx, t.ID)
-		out = append(out, uc.buildEventTableResponse(ctx, t, seats, seatToInvite))
+		out.Tables = append(out.Tables, uc.buildEventTableResponse(ctx, t, seats, seatToInvite, seatHolds))
+	}
+	for _, e := range elements {
+		if roomID == "" || e.RoomID == roomID {
+			out.Elements = append(out.Elements, toFloorElementResponse(e))
+		}
 	}
 	return out, nil
 }
 
-func (uc *EventUseCase) buildEventTableResponse(ctx context.Context, t *entities.EventTable, seats []*entities.EventSeat, seatToInvite map[string]string) *dto.EventTableResponse {
-	if seatToInvite == nil {
-		seatToInvite = make(map[string]string)
+// checkSeatingAccess allows the owner, invited guests and anyone for public events to see the floor plan.
+func (uc *EventUseCase) checkSeatingAccess(ctx context.Context, eventID, callerID string) (*entities.Event, error) {
+	event, err := uc.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	canAccess := event.OwnerID == callerID || event.Visibility == entities.VisibilityPublic
+	if !canAccess && callerID != "" {
+		invited, _ := uc.eventInviteRepo.ExistsByEventAndUser(ctx, eventID, callerID)
+		canAccess = invited
+	}
+	if !canAccess {
+		return nil, errors.New("forbidden: you do not have access to this event")
+	}
+	return event, nil
+}
+
+// seatToInviteMap maps seat ID to the ID of the invite holding it.
+func (uc *EventUseCase) seatToInviteMap(ctx context.Context, eventID string) map[string]string {
+	assignments, _ := uc.eventSeatRepo.ListAssignmentsByEventID(ctx, eventID)
+	seatToInvite := make(map[string]string, len(assignments))
+	for _, a := range assignments {
+		seatToInvite[a.SeatID] = a.InviteID
+	}
+	return seatToInvite
+}
+
+func toEventSeatResponse(seat *entities.EventSeat, seatToInvite map[string]string, seatHolds map[string]time.Time) *dto.EventSeatResponse {
+	status := "available"
+	var inviteID, heldUntil *string
+	if id, ok := seatToInvite[seat.ID]; ok {
+		inviteID = &id
+		status = "taken"
+	} else if seat.Blocked {
+		status = "blocked"
+	} else if exp, ok := seatHolds[seat.ID]; ok {
+		until := exp.Format(time.RFC3339)
+		heldUntil = &until
+		status = "held"
+	}
+	return &dto.EventSeatResponse{
+		ID:               seat.ID,
+		EventTableID:     seat.EventTableID,
+		Label:            seat.Label,
+		DisplayOrder:     seat.DisplayOrder,
+		Accessible:       seat.Accessible,
+		VIP:              seat.VIP,
+		Blocked:          seat.Blocked,
+		ReservedInviteID: seat.ReservedInviteID,
+		Status:           status,
+		InviteID:         inviteID,
+		HeldUntil:        heldUntil,
 	}
+}
+
+func (uc *EventUseCase) buildEventTableResponse(ctx context.Context, t *entities.EventTable, seats []*entities.EventSeat, seatToInvite map[string]string, seatHolds map[string]time.Time) *dto.EventTableResponse {
 	seatResp := make([]*dto.EventSeatResponse, len(seats))
 	for i := range seats {
-		var inviteID *string
-		if id, ok := seatToInvite[seats[i].ID]; ok {
-			inviteID = &id
-		}
-		seatResp[i] = &dto.EventSeatResponse{
-			ID:           seats[i].ID,
-			EventTableID: seats[i].EventTableID,
-			Label:        seats[i].Label,
-			DisplayOrder: seats[i].DisplayOrder,
-			InviteID:     inviteID,
-		}
+		seatResp[i] = toEventSeatResponse(seats[i], seatToInvite, seatHolds)
 	}
 	shape := t.Shape
 	if shape != "rectangular" && shape != "grid" {
@@ -720,20 +1187,29 @@ func (uc *EventUseCase) buildEventTableResponse(ctx context.Context, t *entities
 	return &dto.EventTableResponse{
 		ID:           t.ID,
 		EventID:      t.EventID,
+		RoomID:       t.RoomID,
 		Name:         t.Name,
 		Shape:        shape,
 		Rows:         t.TableRows,
 		Columns:      t.TableColumns,
 		Capacity:     t.Capacity,
 		DisplayOrder: t.DisplayOrder,
+		LabelScheme:  t.SeatLabelScheme,
+		SeatLabels:   t.SeatLabels,
 		PositionX:    t.PositionX,
 		PositionY:    t.PositionY,
+		Width:        t.Width,
+		Height:       t.Height,
+		Rotation:     t.Rotation,
 		Seats:        seatResp,
 	}
 }
 
-// UpdateEventTable updates a table. Owner only. Changing capacity does not add/remove seats (future enhancement).
-func (uc *EventUseCase) UpdateEventTable(ctx context.Context, ownerID, eventID, tableID string, req dto.UpdateEventTableRequest) (*dto.EventTableResponse, error) {
+// UpdateEventTable updates a table. Owner only. When the capacity or grid size changes, seats are added or
+// removed at the end of the display order. Removing seats that guests have chosen fails with a
+// *DisplacedGuestsError listing those guests unless req.Force is set; then they lose their seats and are
+// returned in DisplacedInvites so they can be reseated. A non-empty roomID must be the table's room.
+func (uc *EventUseCase) UpdateEventTable(ctx context.Context, ownerID, eventID, roomID, tableID string, req dto.UpdateEventTableRequest) (*dto.EventTableResponse, error) {
 	event, err := uc.eventRepo.FindByID(ctx, eventID)
 	if err != nil {
 		return nil, err
@@ -741,70 +1217,212 @@ func (uc *EventUseCase) UpdateEventTable(ctx context.Context, ownerID, eventID,
 	if event.OwnerID != ownerID {
 		return nil, errors.New("you are not the owner of this event")
 	}
-	t, err := uc.eventTableRepo.FindByID(ctx, tableID)
+	t, err := uc.findRoomTable(ctx, eventID, roomID, tableID)
 	if err != nil {
 		return nil, err
 	}
-	if t.EventID != eventID {
-		return nil, errors.New("table does not belong to this event")
+	roomChanged := false
+	if req.RoomID != nil && *req.RoomID != t.RoomID {
+		room, err := uc.resolveRoom(ctx, eventID, *req.RoomID)
+		if err != nil {
+			return nil, err
+		}
+		t.RoomID = room.ID
+		roomChanged = true
+	}
+	if req.Name != nil {
+		name, err := normalizeTableName(*req.Name)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			return nil, errors.New("table name cannot be empty")
+		}
+		t.Name = name
 	}
 	if req.Shape == "rectangular" || req.Shape == "round" || req.Shape == "grid" {
 		t.Shape = req.Shape
-		if req.Shape == "grid" && req.Rows != nil && req.Columns != nil && *req.Rows > 0 && *req.Columns > 0 {
-			cap := *req.Rows * *req.Columns
-			if cap <= 50 {
-				t.TableRows = req.Rows
-				t.TableColumns = req.Columns
-				t.Capacity = cap
+		if req.Shape == "grid" && (req.Rows != nil || req.Columns != nil) {
+			if req.Rows == nil || req.Columns == nil || *req.Rows < 1 || *req.Columns < 1 {
+				return nil, errors.New("rows and columns are required for sitting area (min 1 each)")
+			}
+			if *req.Rows > 100 || *req.Columns > 100 {
+				return nil, errors.New("rows and columns must be at most 100 each")
 			}
+			t.TableRows = req.Rows
+			t.TableColumns = req.Columns
+			t.Capacity = *req.Rows * *req.Columns
 		}
 	}
+	if t.Shape == "grid" && (t.TableRows == nil || t.TableColumns == nil) {
+		return nil, errors.New("rows and columns are required for sitting area (min 1 each)")
+	}
 	if req.Capacity > 0 && t.Shape != "grid" {
+		if req.Capacity > 50 {
+			return nil, errors.New("capacity must be between 1 and 50")
+		}
 		t.Capacity = req.Capacity
 	}
+	if err := applySeatLabelScheme(t, req.SeatLabelScheme, req.SeatLabels); err != nil {
+		return nil, err
+	}
 	if req.PositionX != nil {
-		x := *req.PositionX
-		if x < 0 {
-			x = 0
-		}
-		if x > 100 {
-			x = 100
-		}
-		t.PositionX = x
+		t.PositionX = *req.PositionX
 	}
 	if req.PositionY != nil {
-		y := *req.PositionY
-		if y < 0 {
-			y = 0
-		}
-		if y > 100 {
-			y = 100
-		}
-		t.PositionY = y
+		t.PositionY = *req.PositionY
+	}
+	if req.Width != nil {
+		t.Width = *req.Width
+	}
+	if req.Height != nil {
+		t.Height = *req.Height
 	}
+	if t.Width <= 0 || t.Width > 100 || t.Height <= 0 || t.Height > 100 {
+		return nil, errors.New("width and height must be between 0 and 100")
+	}
+	if req.Rotation != nil {
+		t.Rotation = normalizeRotation(*req.Rotation)
+	}
+	moved := roomChanged || req.PositionX != nil || req.PositionY != nil || req.Width != nil || req.Height != nil || req.Rotation != nil
 	if req.DisplayOrder >= 0 {
 		t.DisplayOrder = req.DisplayOrder
 	}
 	t.UpdatedAt = time.Now()
-	if err := uc.eventTableRepo.Update(ctx, t); err != nil {
+	var displaced []*dto.EventInviteResponse
+	var before map[string]string
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotTableUpdated, func(ctx context.Context) error {
+		before = uc.seatToInviteMap(ctx, eventID)
+		if moved {
+			if err := uc.checkTablePlacement(ctx, t); err != nil {
+				return err
+			}
+		}
+		if err := uc.eventTableRepo.Update(ctx, t); err != nil {
+			return err
+		}
+		displaced, err = uc.resizeTableSeats(ctx, t, req.Force)
+		if err != nil {
+			return err
+		}
+		return uc.relabelTableSeats(ctx, t)
+	})
+	if err != nil {
 		return nil, err
 	}
 	seats, _ := uc.eventSeatRepo.ListByEventTableID(ctx, t.ID)
-	invites, _ := uc.eventInviteRepo.ListByEventID(ctx, eventID)
-	seatToInvite := make(map[string]string)
-	for _, inv := range invites {
-		if inv.SeatID != nil {
-			seatToInvite[*inv.SeatID] = inv.ID
+	seatToInvite := uc.seatToInviteMap(ctx, eventID)
+	resp := uc.buildEventTableResponse(ctx, t, seats, seatToInvite, uc.seatHoldMap(ctx, eventID))
+	kind := services.SeatingTableUpdated
+	if moved {
+		kind = services.SeatingTableMoved
+	}
+	uc.notifySeatChanges(ctx, eventID, before)
+	// Viewers get the table without the displaced guests, whose emails are for the owner only.
+	broadcast := *resp
+	uc.seatingNotifier.NotifySeating(ctx, services.SeatingEvent{
+		Type:    kind,
+		EventID: eventID,
+		TableID: t.ID,
+		Data:    &broadcast,
+	})
+	resp.DisplacedInvites = displaced
+	return resp, nil
+}
+
+// resizeTableSeats adds or removes seats so the table has exactly t.Capacity of them. Surplus seats are
+// taken from the end of the display order. If any of them is assigned and force is false, nothing is
+// deleted and a *DisplacedGuestsError is returned. Deleting a seat clears it from invites (ON DELETE SET NULL)
+// and drops its assignment and holds, so the displaced invites only need to be reported.
+func (uc *EventUseCase) resizeTableSeats(ctx context.Context, t *entities.EventTable, force bool) ([]*dto.EventInviteResponse, error) {
+	seats, err := uc.eventSeatRepo.ListByEventTableID(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(seats) < t.Capacity {
+		nextOrder := 0
+		if len(seats) > 0 {
+			nextOrder = seats[len(seats)-1].DisplayOrder + 1
+		}
+		added := make([]*entities.EventSeat, 0, t.Capacity-len(seats))
+		for i := len(seats); i < t.Capacity; i++ {
+			added = append(added, &entities.EventSeat{
+				EventTableID: t.ID,
+				Label:        seatLabel(t, i),
+				DisplayOrder: nextOrder,
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
+			})
+			nextOrder++
+		}
+		return nil, uc.eventSeatRepo.CreateBulk(ctx, added)
+	}
+	if len(seats) == t.Capacity {
+		return nil, nil
+	}
+	surplus := seats[t.Capacity:]
+	seatToInvite := uc.seatToInviteMap(ctx, t.EventID)
+	var inviteIDs []string
+	seen := make(map[string]bool)
+	for _, seat := range surplus {
+		inviteID, ok := seatToInvite[seat.ID]
+		if !ok || seen[inviteID] {
+			continue
+		}
+		seen[inviteID] = true
+		inviteIDs = append(inviteIDs, inviteID)
+	}
+	if len(inviteIDs) > 0 && !force {
+		invites, err := uc.invitesByID(ctx, inviteIDs)
+		if err != nil {
+			return nil, err
+		}
+		return nil, &DisplacedGuestsError{Invites: invites}
+	}
+	for _, seat := range surplus {
+		if err := uc.eventSeatRepo.Delete(ctx, seat); err != nil {
+			return nil, err
+		}
+	}
+	return uc.invitesByID(ctx, inviteIDs)
+}
+
+// relabelTableSeats gives every seat of the table the label its position calls for under the table's
+// labeling scheme, saving only the seats whose label changes.
+func (uc *EventUseCase) relabelTableSeats(ctx context.Context, t *entities.EventTable) error {
+	seats, err := uc.eventSeatRepo.ListByEventTableID(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+	for i, seat := range seats {
+		label := seatLabel(t, i)
+		if seat.Label == label {
+			continue
 		}
-		if inv.GuestSeatID != nil {
-			seatToInvite[*inv.GuestSeatID] = inv.ID
+		seat.Label = label
+		seat.UpdatedAt = time.Now()
+		if err := uc.eventSeatRepo.Update(ctx, seat); err != nil {
+			return err
 		}
 	}
-	return uc.buildEventTableResponse(ctx, t, seats, seatToInvite), nil
+	return nil
+}
+
+// invitesByID loads invites in the given order and converts them to responses.
+func (uc *EventUseCase) invitesByID(ctx context.Context, ids []string) ([]*dto.EventInviteResponse, error) {
+	out := make([]*dto.EventInviteResponse, 0, len(ids))
+	for _, id := range ids {
+		invite, err := uc.eventInviteRepo.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, uc.toEventInviteResponse(invite))
+	}
+	return out, nil
 }
 
 // ReorderEventTables updates display_order of tables to match the given order. Owner only.
-func (uc *EventUseCase) ReorderEventTables(ctx context.Context, ownerID, eventID string, orderedTableIDs []string) error {
+func (uc *EventUseCase) ReorderEventTables(ctx context.Context, ownerID, eventID, roomID string, orderedTableIDs []string) error {
 	event, err := uc.eventRepo.FindByID(ctx, eventID)
 	if err != nil {
 		return err
@@ -816,6 +1434,12 @@ func (uc *EventUseCase) ReorderEventTables(ctx context.Context, ownerID, eventID
 	if err != nil {
 		return err
 	}
+	if roomID != "" {
+		if _, err := uc.resolveRoom(ctx, eventID, roomID); err != nil {
+			return err
+		}
+		tables = tablesInRoom(tables, roomID)
+	}
 	if len(orderedTableIDs) != len(tables) {
 		return errors.New("table order must include all tables exactly once")
 	}
@@ -823,22 +1447,33 @@ func (uc *EventUseCase) ReorderEventTables(ctx context.Context, ownerID, eventID
 	for _, t := range tables {
 		idToTable[t.ID] = t
 	}
-	for i, id := range orderedTableIDs {
-		t, ok := idToTable[id]
-		if !ok {
-			return errors.New("invalid table id in order")
-		}
-		t.DisplayOrder = i
-		t.UpdatedAt = time.Now()
-		if err := uc.eventTableRepo.Update(ctx, t); err != nil {
-			return err
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotTablesReordered, func(ctx context.Context) error {
+		for i, id := range orderedTableIDs {
+			t, ok := idToTable[id]
+			if !ok {
+				return errors.New("invalid table id in order")
+			}
+			t.DisplayOrder = i
+			t.UpdatedAt = time.Now()
+			if err := uc.eventTableRepo.Update(ctx, t); err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+	uc.seatingNotifier.NotifySeating(ctx, services.SeatingEvent{
+		Type:     services.SeatingTablesReordered,
+		EventID:  eventID,
+		TableIDs: orderedTableIDs,
+	})
 	return nil
 }
 
 // DeleteEventTable deletes a table and its seats. Owner only.
-func (uc *EventUseCase) DeleteEventTable(ctx context.Context, ownerID, eventID, tableID string) error {
+func (uc *EventUseCase) DeleteEventTable(ctx context.Context, ownerID, eventID, roomID, tableID string) error {
 	event, err := uc.eventRepo.FindByID(ctx, eventID)
 	if err != nil {
 		return err
@@ -846,13 +1481,121 @@ func (uc *EventUseCase) DeleteEventTable(ctx context.Context, ownerID, eventID,
 	if event.OwnerID != ownerID {
 		return errors.New("you are not the owner of this event")
 	}
-	t, err := uc.eventTableRepo.FindByID(ctx, tableID)
+	t, err := uc.findRoomTable(ctx, eventID, roomID, tableID)
 	if err != nil {
 		return err
 	}
-	if t.EventID != eventID {
-		return errors.New("table does not belong to this event")
+	var before map[string]string
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotTableDeleted, func(ctx context.Context) error {
+		before = uc.seatToInviteMap(ctx, eventID)
+		if err := uc.eventSeatRepo.DeleteByTableID(ctx, tableID); err != nil {
+			return err
+		}
+		return uc.eventTableRepo.Delete(ctx, t)
+	})
+	if err != nil {
+		return err
+	}
+	uc.notifySeatChanges(ctx, eventID, before)
+	uc.seatingNotifier.NotifySeating(ctx, services.SeatingEvent{
+		Type:    services.SeatingTableRemoved,
+		EventID: eventID,
+		TableID: t.ID,
+	})
+	return nil
+}
+
+// UpdateEventSeat changes a seat's accessible / VIP / blocked flags and its reservation. Owner only.
+// An assigned seat cannot be blocked, nor reserved for anyone but its occupant.
+func (uc *EventUseCase) UpdateEventSeat(ctx context.Context, ownerID, eventID, tableID, seatID string, req dto.UpdateEventSeatRequest) (*dto.EventSeatResponse, error) {
+	if _, err := uc.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	seat, err := uc.findEventSeat(ctx, eventID, seatID)
+	if err != nil {
+		return nil, err
+	}
+	if seat.EventTableID != tableID {
+		return nil, errors.New("seat does not belong to this table")
+	}
+	if req.Accessible != nil {
+		seat.Accessible = *req.Accessible
+	}
+	if req.VIP != nil {
+		seat.VIP = *req.VIP
+	}
+	if req.Blocked != nil {
+		seat.Blocked = *req.Blocked
+	}
+	if req.ReservedInviteID != nil {
+		if *req.ReservedInviteID == "" {
+			seat.ReservedInviteID = nil
+		} else {
+			invite, err := uc.eventInviteRepo.FindByID(ctx, *req.ReservedInviteID)
+			if err != nil || invite.EventID != eventID {
+				return nil, errors.New("invite not found")
+			}
+			reservedFor := invite.ID
+			seat.ReservedInviteID = &reservedFor
+		}
+	}
+	var seatToInvite map[string]string
+	err = uc.withSeatingSnapshot(ctx, eventID, ownerID, snapshotSeatUpdated, func(ctx context.Context) error {
+		if err := uc.eventSeatRepo.LockByIDs(ctx, []string{seat.ID}); err != nil {
+			return err
+		}
+		seatToInvite = uc.seatToInviteMap(ctx, eventID)
+		if occupant, ok := seatToInvite[seat.ID]; ok {
+			if seat.Blocked {
+				return apperrors.ErrSeatTaken
+			}
+			if seat.ReservedInviteID != nil && *seat.ReservedInviteID != occupant {
+				return apperrors.ErrSeatTaken
+			}
+		}
+		seat.UpdatedAt = time.Now()
+		return uc.eventSeatRepo.Update(ctx, seat)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toEventSeatResponse(seat, seatToInvite, uc.seatHoldMap(ctx, eventID)), nil
+}
+
+// checkTablePlacement rejects a position that leaves the floor or overlaps another table of the same room,
+// suggesting the nearest position where the table would fit.
+func (uc *EventUseCase) checkTablePlacement(ctx context.Context, t *entities.EventTable) error {
+	tables, err := uc.eventTableRepo.ListByEventID(ctx, t.EventID)
+	if err != nil {
+		return err
 	}
-	_ = uc.eventSeatRepo.DeleteByTableID(ctx, tableID)
-	return uc.eventTableRepo.Delete(ctx, t)
-}
\ No newline at end of file
+	others := placedTableFootprints(tablesInRoom(tables, t.RoomID), t.ID)
+	footprint := eventTableFootprint(t)
+	var placementErr error
+	switch {
+	case !footprint.inBounds():
+		placementErr = apperrors.ErrTableOutOfBounds
+	case footprint.overlapsAny(others):
+		placementErr = apperrors.ErrTableOverlap
+	default:
+		return nil
+	}
+	e := &TablePlacementError{Err: placementErr}
+	if x, y, ok := nearestFreePosition(t.PositionX, t.PositionY, t.Width, t.Height, t.Rotation, others); ok {
+		e.SuggestedX, e.SuggestedY = &x, &y
+	}
+	return e
+}
+
+// placedTableFootprints returns the footprints of the tables except excludeID. Tables still at (0, 0)
+// were never placed (the floor chart lays them out itself) and are ignored.
+func placedTableFootprints(tables []*entities.EventTable, excludeID string) []floorRect {
+	out := make([]floorRect, 0, len(tables))
+	for _, t := range tables {
+		if t.ID == excludeID || (t.PositionX == 0 && t.PositionY == 0) {
+			continue
+		}
+		out = append(out, eventTableFootprint(t))
+	}
+	return out
+}
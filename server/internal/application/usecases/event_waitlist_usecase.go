@@ -0,0 +1,120 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+const inviteStatusWaitlisted = "waitlisted"
+
+// inviteHeads is the number of attendees an invite stands for: the guest and their companions.
+func inviteHeads(inv *entities.EventInvite) int {
+	return 1 + len(inv.Companions)
+}
+
+// attendance returns the attendees of the event's confirmed invites and the waitlist in FIFO order,
+// leaving out skipID.
+func (uc *EventUseCase) attendance(ctx context.Context, eventID, skipID string) (int, []*entities.EventInvite, error) {
+	invites, err := uc.eventInviteRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return 0, nil, err
+	}
+	heads := 0
+	var waitlist []*entities.EventInvite
+	for _, inv := range invites {
+		if inv.ID == skipID && skipID != "" {
+			continue
+		}
+		switch inv.Status {
+		case "confirmed":
+			heads += inviteHeads(inv)
+		case inviteStatusWaitlisted:
+			waitlist = append(waitlist, inv)
+		}
+	}
+	sort.SliceStable(waitlist, func(i, j int) bool {
+		return waitlistedAt(waitlist[i]).Before(waitlistedAt(waitlist[j]))
+	})
+	return heads, waitlist, nil
+}
+
+func waitlistedAt(inv *entities.EventInvite) time.Time {
+	if inv.WaitlistedAt != nil {
+		return *inv.WaitlistedAt
+	}
+	return inv.UpdatedAt
+}
+
+// admitOrWaitlist decides whether a confirming invite fits under the event capacity. It fits when its
+// attendees fit and nobody is waiting ahead of it; otherwise it joins the end of the waitlist and gives
+// up its seats. An invite that was already confirmed is never demoted: growing it past the capacity
+// fails with ErrEventFull. previous is the invite's status before this RSVP.
+func (uc *EventUseCase) admitOrWaitlist(ctx context.Context, event *entities.Event, invite *entities.EventInvite, previous string) error {
+	if event.Capacity == nil {
+		invite.WaitlistedAt = nil
+		return nil
+	}
+	heads, waitlist, err := uc.attendance(ctx, event.ID, invite.ID)
+	if err != nil {
+		return err
+	}
+	fits := heads+inviteHeads(invite) <= *event.Capacity
+	if previous == "confirmed" {
+		if !fits {
+			return apperrors.ErrEventFull
+		}
+		return nil
+	}
+	ahead := len(waitlist) > 0
+	if previous == inviteStatusWaitlisted && invite.WaitlistedAt != nil {
+		ahead = len(waitlist) > 0 && waitlistedAt(waitlist[0]).Before(*invite.WaitlistedAt)
+	}
+	if fits && !ahead {
+		invite.WaitlistedAt = nil
+		return nil
+	}
+	invite.Status = inviteStatusWaitlisted
+	if invite.WaitlistedAt == nil {
+		now := time.Now()
+		invite.WaitlistedAt = &now
+	}
+	clearPartySeats(invite)
+	return nil
+}
+
+// promoteWaitlist confirms waitlisted invites in FIFO order while the head of the line fits under the
+// capacity, and returns them. Promoted guests have no seat yet. Call it inside a transaction.
+func (uc *EventUseCase) promoteWaitlist(ctx context.Context, event *entities.Event) ([]*entities.EventInvite, error) {
+	heads, waitlist, err := uc.attendance(ctx, event.ID, "")
+	if err != nil {
+		return nil, err
+	}
+	var promoted []*entities.EventInvite
+	for _, inv := range waitlist {
+		if event.Capacity != nil && heads+inviteHeads(inv) > *event.Capacity {
+			break
+		}
+		inv.Status = "confirmed"
+		inv.WaitlistedAt = nil
+		inv.UpdatedAt = time.Now()
+		if err := uc.eventInviteRepo.Update(ctx, inv); err != nil {
+			return nil, err
+		}
+		heads += inviteHeads(inv)
+		promoted = append(promoted, inv)
+	}
+	return promoted, nil
+}
+
+// notifyPromoted emails every promoted guest. Call it after the transaction has committed.
+func (uc *EventUseCase) notifyPromoted(ctx context.Context, event *entities.Event, promoted []*entities.EventInvite) {
+	rsvpPath := fmt.Sprintf("/events/%s/rsvp", event.ID)
+	for _, inv := range promoted {
+		_ = uc.mailer.SendWaitlistPromotionEmail(ctx, inv.Email, event.Name, rsvpPath)
+	}
+}
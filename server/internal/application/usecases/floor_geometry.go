@@ -0,0 +1,89 @@
+package usecases
+
+import (
+	"math"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+// floorRect is an axis-aligned box on the floor plan, in the 0-100 percentage space used for positions.
+type floorRect struct {
+	MinX, MinY, MaxX, MaxY float64
+}
+
+// tableFootprint returns the box covered by a table of the given size rotated around its center at (x, y).
+// Rotated tables are approximated by their bounding box.
+func tableFootprint(x, y, width, height, rotation float64) floorRect {
+	rad := rotation * math.Pi / 180
+	cos, sin := math.Abs(math.Cos(rad)), math.Abs(math.Sin(rad))
+	// Right angles are taken exactly: cos(90°) is not quite zero in floating point, which would make a
+	// turned table a hair wider than its size and keep it off the edge of the floor.
+	switch normalizeRotation(rotation) {
+	case 0, 180:
+		cos, sin = 1, 0
+	case 90, 270:
+		cos, sin = 0, 1
+	}
+	halfW := (width*cos + height*sin) / 2
+	halfH := (width*sin + height*cos) / 2
+	return floorRect{MinX: x - halfW, MinY: y - halfH, MaxX: x + halfW, MaxY: y + halfH}
+}
+
+func eventTableFootprint(t *entities.EventTable) floorRect {
+	return tableFootprint(t.PositionX, t.PositionY, t.Width, t.Height, t.Rotation)
+}
+
+// overlaps reports whether the boxes share any area; touching edges do not count.
+func (r floorRect) overlaps(o floorRect) bool {
+	return r.MinX < o.MaxX && o.MinX < r.MaxX && r.MinY < o.MaxY && o.MinY < r.MaxY
+}
+
+func (r floorRect) inBounds() bool {
+	return r.MinX >= 0 && r.MinY >= 0 && r.MaxX <= 100 && r.MaxY <= 100
+}
+
+func (r floorRect) overlapsAny(others []floorRect) bool {
+	for _, o := range others {
+		if r.overlaps(o) {
+			return true
+		}
+	}
+	return false
+}
+
+// nearestFreePosition searches the floor on a 1% grid for the center closest to (x, y) where a table of
+// the given size and rotation fits inside the floor without overlapping others. ok is false when there
+// is no such spot.
+func nearestFreePosition(x, y, width, height, rotation float64, others []floorRect) (bestX, bestY float64, ok bool) {
+	bestDist := math.Inf(1)
+	for cx := 0.0; cx <= 100; cx++ {
+		for cy := 0.0; cy <= 100; cy++ {
+			r := tableFootprint(cx, cy, width, height, rotation)
+			if !r.inBounds() || r.overlapsAny(others) {
+				continue
+			}
+			if d := math.Hypot(cx-x, cy-y); d < bestDist {
+				bestDist, bestX, bestY, ok = d, cx, cy, true
+			}
+		}
+	}
+	return bestX, bestY, ok
+}
+
+// defaultTableSize is the footprint given to new tables, in floor percentages.
+func defaultTableSize(shape string, rows, columns *int) (width, height float64) {
+	switch shape {
+	case "rectangular":
+		return 16, 8
+	case "grid":
+		if rows != nil && columns != nil {
+			return math.Min(100, float64(*columns)*3+4), math.Min(100, float64(*rows)*3+6)
+		}
+	}
+	return 10, 10
+}
+
+// normalizeRotation maps any angle in degrees to [0, 360).
+func normalizeRotation(deg float64) float64 {
+	return math.Mod(math.Mod(deg, 360)+360, 360)
+}
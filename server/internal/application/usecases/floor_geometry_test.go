@@ -0,0 +1,110 @@
+package usecases
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTableFootprint(t *testing.T) {
+	half45 := 30 * math.Sqrt2 / 4 // (20 + 10) * cos(45°) / 2
+	tests := []struct {
+		name                         string
+		x, y, width, height, rotated float64
+		want                         floorRect
+	}{
+		{"unrotated", 50, 50, 20, 10, 0, floorRect{40, 45, 60, 55}},
+		{"quarter turn swaps the sides", 50, 50, 20, 10, 90, floorRect{45, 40, 55, 60}},
+		{"half turn", 50, 50, 20, 10, 180, floorRect{40, 45, 60, 55}},
+		{"three quarter turn", 50, 50, 20, 10, 270, floorRect{45, 40, 55, 60}},
+		{"45 degrees covers the bounding box", 50, 50, 20, 10, 45, floorRect{50 - half45, 50 - half45, 50 + half45, 50 + half45}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tableFootprint(tt.x, tt.y, tt.width, tt.height, tt.rotated)
+			if !rectNear(got, tt.want) {
+				t.Errorf("tableFootprint() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloorRectOverlaps(t *testing.T) {
+	a := floorRect{0, 0, 10, 10}
+	tests := []struct {
+		name string
+		b    floorRect
+		want bool
+	}{
+		{"shared area", floorRect{5, 5, 15, 15}, true},
+		{"inside", floorRect{2, 2, 3, 3}, true},
+		{"touching edges", floorRect{10, 0, 20, 10}, false},
+		{"apart", floorRect{20, 20, 30, 30}, false},
+	}
+	for _, tt := range tests {
+		if got := a.overlaps(tt.b); got != tt.want {
+			t.Errorf("%s: overlaps() = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := tt.b.overlaps(a); got != tt.want {
+			t.Errorf("%s: overlaps() reversed = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNearestFreePosition(t *testing.T) {
+	tests := []struct {
+		name                         string
+		x, y, width, height, rotated float64
+		others                       []floorRect
+		wantX, wantY                 float64
+		wantOK                       bool
+	}{
+		{name: "free spot is kept", x: 50, y: 50, width: 10, height: 10, wantX: 50, wantY: 50, wantOK: true},
+		{name: "pushed inside the floor", x: 2, y: 50, width: 20, height: 10, wantX: 10, wantY: 50, wantOK: true},
+		{name: "rotated table is narrower", x: 2, y: 50, width: 20, height: 10, rotated: 90, wantX: 5, wantY: 50, wantOK: true},
+		{
+			name: "moved off another table", x: 50, y: 50, width: 10, height: 10,
+			others: []floorRect{{40, 0, 60, 100}},
+			wantX:  35, wantY: 50, wantOK: true,
+		},
+		{
+			name: "rotated table moved off another table", x: 50, y: 50, width: 20, height: 10, rotated: 90,
+			others: []floorRect{{40, 0, 60, 100}},
+			wantX:  35, wantY: 50, wantOK: true,
+		},
+		{name: "larger than the floor", x: 50, y: 50, width: 120, height: 10, wantOK: false},
+		{name: "floor is full", x: 50, y: 50, width: 10, height: 10, others: []floorRect{{0, 0, 100, 100}}, wantOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y, ok := nearestFreePosition(tt.x, tt.y, tt.width, tt.height, tt.rotated, tt.others)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (x != tt.wantX || y != tt.wantY) {
+				t.Errorf("position = (%v, %v), want (%v, %v)", x, y, tt.wantX, tt.wantY)
+			}
+		})
+	}
+}
+
+func TestNormalizeRotation(t *testing.T) {
+	tests := []struct{ in, want float64 }{
+		{0, 0},
+		{90, 90},
+		{360, 0},
+		{450, 90},
+		{-90, 270},
+		{-720, 0},
+	}
+	for _, tt := range tests {
+		if got := normalizeRotation(tt.in); got != tt.want {
+			t.Errorf("normalizeRotation(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func rectNear(a, b floorRect) bool {
+	const eps = 1e-9
+	return math.Abs(a.MinX-b.MinX) < eps && math.Abs(a.MinY-b.MinY) < eps &&
+		math.Abs(a.MaxX-b.MaxX) < eps && math.Abs(a.MaxY-b.MaxY) < eps
+}
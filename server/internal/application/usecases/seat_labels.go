@@ -0,0 +1,96 @@
+package usecases
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+const maxTableNameLength = 100
+
+// seatLabel returns the label of the i-th seat (0-based, in display order) under the table's scheme.
+// Custom labels must already have been checked to cover every seat.
+func seatLabel(t *entities.EventTable, i int) string {
+	switch t.SeatLabelScheme {
+	case entities.SeatLabelRowLetter:
+		columns := t.Capacity
+		if t.Shape == "grid" && t.TableColumns != nil && *t.TableColumns > 0 {
+			columns = *t.TableColumns
+		}
+		return rowLetters(i/columns) + strconv.Itoa(i%columns+1)
+	case entities.SeatLabelCustom:
+		if i < len(t.SeatLabels) {
+			return t.SeatLabels[i]
+		}
+	}
+	return strconv.Itoa(i + 1)
+}
+
+// rowLetters turns a 0-based row index into A, B ... Z, AA, AB ...
+func rowLetters(row int) string {
+	s := ""
+	for row >= 0 {
+		s = string(rune('A'+row%26)) + s
+		row = row/26 - 1
+	}
+	return s
+}
+
+// applySeatLabelScheme validates and sets the table's labeling scheme. An empty scheme keeps the current
+// one; labels is only used (and required) for the custom scheme.
+func applySeatLabelScheme(t *entities.EventTable, scheme string, labels []string) error {
+	if scheme != "" {
+		switch scheme {
+		case entities.SeatLabelNumeric, entities.SeatLabelRowLetter, entities.SeatLabelCustom:
+			t.SeatLabelScheme = scheme
+		default:
+			return errors.New("seat_label_scheme must be numeric, row_letter or custom")
+		}
+	}
+	if t.SeatLabelScheme == "" {
+		t.SeatLabelScheme = entities.SeatLabelNumeric
+	}
+	if t.SeatLabelScheme != entities.SeatLabelCustom {
+		t.SeatLabels = entities.StringList{}
+		return nil
+	}
+	if labels != nil {
+		t.SeatLabels = make(entities.StringList, len(labels))
+		for i, l := range labels {
+			t.SeatLabels[i] = strings.TrimSpace(l)
+		}
+	}
+	return validateCustomSeatLabels(t)
+}
+
+// validateCustomSeatLabels checks that a custom scheme has a distinct, non-empty label for every seat.
+func validateCustomSeatLabels(t *entities.EventTable) error {
+	if t.SeatLabelScheme != entities.SeatLabelCustom {
+		return nil
+	}
+	if len(t.SeatLabels) < t.Capacity {
+		return errors.New("seat_labels must have a label for every seat")
+	}
+	seen := make(map[string]bool, len(t.SeatLabels))
+	for _, l := range t.SeatLabels {
+		if l == "" {
+			return errors.New("seat labels cannot be empty")
+		}
+		if seen[l] {
+			return errors.New("seat labels must be unique")
+		}
+		seen[l] = true
+	}
+	return nil
+}
+
+// normalizeTableName trims name and checks its length. An empty result means "keep the default".
+func normalizeTableName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if len(name) > maxTableNameLength {
+		return "", errors.New("table name must be at most 100 characters")
+	}
+	return name, nil
+}
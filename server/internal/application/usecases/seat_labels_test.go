@@ -0,0 +1,97 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+func TestSeatLabel(t *testing.T) {
+	columns := 4
+	tests := []struct {
+		name  string
+		table entities.EventTable
+		i     int
+		want  string
+	}{
+		{"numeric first", entities.EventTable{SeatLabelScheme: entities.SeatLabelNumeric, Capacity: 10}, 0, "1"},
+		{"numeric last", entities.EventTable{SeatLabelScheme: entities.SeatLabelNumeric, Capacity: 10}, 9, "10"},
+		{"empty scheme is numeric", entities.EventTable{Capacity: 4}, 2, "3"},
+		{"row letter round table", entities.EventTable{SeatLabelScheme: entities.SeatLabelRowLetter, Shape: "round", Capacity: 8}, 7, "A8"},
+		{"row letter grid first row", entities.EventTable{SeatLabelScheme: entities.SeatLabelRowLetter, Shape: "grid", Capacity: 12, TableColumns: &columns}, 3, "A4"},
+		{"row letter grid second row", entities.EventTable{SeatLabelScheme: entities.SeatLabelRowLetter, Shape: "grid", Capacity: 12, TableColumns: &columns}, 5, "B2"},
+		{"custom", entities.EventTable{SeatLabelScheme: entities.SeatLabelCustom, Capacity: 2, SeatLabels: entities.StringList{"Bride", "Groom"}}, 1, "Groom"},
+		{"custom without a label", entities.EventTable{SeatLabelScheme: entities.SeatLabelCustom, Capacity: 3, SeatLabels: entities.StringList{"Bride"}}, 2, "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := seatLabel(&tt.table, tt.i); got != tt.want {
+				t.Errorf("seatLabel(%d) = %q, want %q", tt.i, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRowLetters(t *testing.T) {
+	tests := []struct {
+		row  int
+		want string
+	}{
+		{0, "A"},
+		{1, "B"},
+		{25, "Z"},
+		{26, "AA"},
+		{27, "AB"},
+		{701, "ZZ"},
+		{702, "AAA"},
+	}
+	for _, tt := range tests {
+		if got := rowLetters(tt.row); got != tt.want {
+			t.Errorf("rowLetters(%d) = %q, want %q", tt.row, got, tt.want)
+		}
+	}
+}
+
+func TestApplySeatLabelScheme(t *testing.T) {
+	tests := []struct {
+		name       string
+		table      entities.EventTable
+		scheme     string
+		labels     []string
+		wantScheme string
+		wantErr    bool
+	}{
+		{name: "defaults to numeric", table: entities.EventTable{Capacity: 2}, wantScheme: entities.SeatLabelNumeric},
+		{name: "keeps the current scheme", table: entities.EventTable{Capacity: 2, SeatLabelScheme: entities.SeatLabelRowLetter}, wantScheme: entities.SeatLabelRowLetter},
+		{name: "row letter", table: entities.EventTable{Capacity: 2}, scheme: entities.SeatLabelRowLetter, wantScheme: entities.SeatLabelRowLetter},
+		{name: "custom trims labels", table: entities.EventTable{Capacity: 2}, scheme: entities.SeatLabelCustom, labels: []string{" A ", "B"}, wantScheme: entities.SeatLabelCustom},
+		{name: "unknown scheme", table: entities.EventTable{Capacity: 2}, scheme: "roman", wantErr: true},
+		{name: "custom with too few labels", table: entities.EventTable{Capacity: 3}, scheme: entities.SeatLabelCustom, labels: []string{"A", "B"}, wantErr: true},
+		{name: "custom with an empty label", table: entities.EventTable{Capacity: 2}, scheme: entities.SeatLabelCustom, labels: []string{"A", " "}, wantErr: true},
+		{name: "custom with duplicates", table: entities.EventTable{Capacity: 2}, scheme: entities.SeatLabelCustom, labels: []string{"A", "A"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := applySeatLabelScheme(&tt.table, tt.scheme, tt.labels)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applySeatLabelScheme() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && tt.table.SeatLabelScheme != tt.wantScheme {
+				t.Errorf("scheme = %q, want %q", tt.table.SeatLabelScheme, tt.wantScheme)
+			}
+		})
+	}
+}
+
+func TestApplySeatLabelSchemeClearsLabels(t *testing.T) {
+	table := entities.EventTable{Capacity: 2, SeatLabelScheme: entities.SeatLabelCustom, SeatLabels: entities.StringList{"A", "B"}}
+	if err := applySeatLabelScheme(&table, entities.SeatLabelNumeric, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(table.SeatLabels) != 0 {
+		t.Errorf("SeatLabels = %v, want none", table.SeatLabels)
+	}
+	if got := seatLabel(&table, 1); got != "2" {
+		t.Errorf("seatLabel(1) = %q, want %q", got, "2")
+	}
+}
@@ -0,0 +1,229 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+)
+
+// SeatingConstraintUseCase manages "together" / "apart" rules between guests and checks the seating against them.
+// Rules are only visible to the event owner.
+type SeatingConstraintUseCase struct {
+	eventRepo      repositories.EventRepository
+	inviteRepo     repositories.EventInviteRepository
+	constraintRepo repositories.SeatingConstraintRepository
+	eventUseCase   *EventUseCase
+}
+
+func NewSeatingConstraintUseCase(
+	eventRepo repositories.EventRepository,
+	inviteRepo repositories.EventInviteRepository,
+	constraintRepo repositories.SeatingConstraintRepository,
+	eventUseCase *EventUseCase,
+) *SeatingConstraintUseCase {
+	return &SeatingConstraintUseCase{
+		eventRepo:      eventRepo,
+		inviteRepo:     inviteRepo,
+		constraintRepo: constraintRepo,
+		eventUseCase:   eventUseCase,
+	}
+}
+
+func (uc *SeatingConstraintUseCase) requireOwner(ctx context.Context, ownerID, eventID string) error {
+	event, err := uc.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if event.OwnerID != ownerID {
+		return errors.New("you are not the owner of this event")
+	}
+	return nil
+}
+
+func (uc *SeatingConstraintUseCase) ListConstraints(ctx context.Context, ownerID, eventID string) ([]*dto.SeatingConstraintResponse, error) {
+	if err := uc.requireOwner(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	list, err := uc.constraintRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*dto.SeatingConstraintResponse, len(list))
+	for i, c := range list {
+		out[i] = toSeatingConstraintResponse(c)
+	}
+	return out, nil
+}
+
+func (uc *SeatingConstraintUseCase) CreateConstraint(ctx context.Context, ownerID, eventID string, req dto.SeatingConstraintRequest) (*dto.SeatingConstraintResponse, error) {
+	if err := uc.requireOwner(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	c := &entities.SeatingConstraint{
+		EventID:   eventID,
+		CreatedAt: time.Now(),
+	}
+	if err := uc.applyRequest(ctx, c, req); err != nil {
+		return nil, err
+	}
+	if err := uc.constraintRepo.Create(ctx, c); err != nil {
+		return nil, err
+	}
+	return toSeatingConstraintResponse(c), nil
+}
+
+func (uc *SeatingConstraintUseCase) UpdateConstraint(ctx context.Context, ownerID, eventID, constraintID string, req dto.SeatingConstraintRequest) (*dto.SeatingConstraintResponse, error) {
+	c, err := uc.findConstraint(ctx, ownerID, eventID, constraintID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.applyRequest(ctx, c, req); err != nil {
+		return nil, err
+	}
+	if err := uc.constraintRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	return toSeatingConstraintResponse(c), nil
+}
+
+func (uc *SeatingConstraintUseCase) DeleteConstraint(ctx context.Context, ownerID, eventID, constraintID string) error {
+	c, err := uc.findConstraint(ctx, ownerID, eventID, constraintID)
+	if err != nil {
+		return err
+	}
+	return uc.constraintRepo.Delete(ctx, c)
+}
+
+// ValidateSeating checks each rule against the current seating chart and lists every violation.
+// A guest's table is the table of their own seat; an "apart" rule also counts their companions' tables.
+func (uc *SeatingConstraintUseCase) ValidateSeating(ctx context.Context, ownerID, eventID string) (*dto.SeatingConstraintReportResponse, error) {
+	if err := uc.requireOwner(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	constraints, err := uc.constraintRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	seating, err := uc.eventUseCase.ListEventSeating(ctx, eventID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	seatTable := make(map[string]string)
+	inviteTables := make(map[string][]string)
+	for _, t := range seating.Tables {
+		for _, seat := range t.Seats {
+			seatTable[seat.ID] = t.ID
+			if seat.InviteID != nil && !containsString(inviteTables[*seat.InviteID], t.ID) {
+				inviteTables[*seat.InviteID] = append(inviteTables[*seat.InviteID], t.ID)
+			}
+		}
+	}
+	invites, err := uc.inviteRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	inviteByID := make(map[string]*entities.EventInvite, len(invites))
+	for _, inv := range invites {
+		inviteByID[inv.ID] = inv
+	}
+	primaryTable := func(inviteID string) string {
+		inv := inviteByID[inviteID]
+		if inv == nil || inv.SeatID == nil {
+			return ""
+		}
+		return seatTable[*inv.SeatID]
+	}
+
+	report := &dto.SeatingConstraintReportResponse{
+		Checked:    len(constraints),
+		Violations: []*dto.SeatingConstraintViolation{},
+	}
+	for _, c := range constraints {
+		v := &dto.SeatingConstraintViolation{Constraint: *toSeatingConstraintResponse(c)}
+		if inv := inviteByID[c.FirstInviteID]; inv != nil {
+			v.FirstEmail = inv.Email
+		}
+		if inv := inviteByID[c.SecondInviteID]; inv != nil {
+			v.SecondEmail = inv.Email
+		}
+		switch c.Kind {
+		case entities.SeatingConstraintTogether:
+			first, second := primaryTable(c.FirstInviteID), primaryTable(c.SecondInviteID)
+			switch {
+			case first == "" || second == "":
+				v.Reason = "unseated"
+			case first != second:
+				v.Reason = "different_tables"
+				v.TableIDs = []string{first, second}
+			}
+		case entities.SeatingConstraintApart:
+			for _, tableID := range inviteTables[c.FirstInviteID] {
+				if containsString(inviteTables[c.SecondInviteID], tableID) {
+					v.Reason = "same_table"
+					v.TableIDs = append(v.TableIDs, tableID)
+				}
+			}
+		}
+		if v.Reason != "" {
+			report.Violations = append(report.Violations, v)
+		}
+	}
+	return report, nil
+}
+
+func (uc *SeatingConstraintUseCase) findConstraint(ctx context.Context, ownerID, eventID, constraintID string) (*entities.SeatingConstraint, error) {
+	if err := uc.requireOwner(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	c, err := uc.constraintRepo.FindByID(ctx, constraintID)
+	if err != nil || c.EventID != eventID {
+		return nil, errors.New("seating rule not found")
+	}
+	return c, nil
+}
+
+// applyRequest copies req onto c after checking that both invites belong to the event.
+func (uc *SeatingConstraintUseCase) applyRequest(ctx context.Context, c *entities.SeatingConstraint, req dto.SeatingConstraintRequest) error {
+	c.Kind = entities.SeatingConstraintKind(strings.ToLower(strings.TrimSpace(req.Kind)))
+	c.FirstInviteID = req.FirstInviteID
+	c.SecondInviteID = req.SecondInviteID
+	c.Note = strings.TrimSpace(req.Note)
+	c.UpdatedAt = time.Now()
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	for _, id := range []string{c.FirstInviteID, c.SecondInviteID} {
+		inv, err := uc.inviteRepo.FindByID(ctx, id)
+		if err != nil || inv.EventID != c.EventID {
+			return errors.New("invite not found")
+		}
+	}
+	return nil
+}
+
+func toSeatingConstraintResponse(c *entities.SeatingConstraint) *dto.SeatingConstraintResponse {
+	return &dto.SeatingConstraintResponse{
+		ID:             c.ID,
+		EventID:        c.EventID,
+		Kind:           string(c.Kind),
+		FirstInviteID:  c.FirstInviteID,
+		SecondInviteID: c.SecondInviteID,
+		Note:           c.Note,
+		CreatedAt:      c.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      c.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
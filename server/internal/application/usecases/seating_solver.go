@@ -0,0 +1,57 @@
+package usecases
+
+import "sort"
+
+// solverTable is a table with its free seat IDs in display order.
+type solverTable struct {
+	ID        string
+	FreeSeats []string
+}
+
+// solverParty is a guest and their companions, who must sit at the same table.
+type solverParty struct {
+	InviteID string
+	Size     int
+}
+
+// solverPlacement puts a party at a table; SeatIDs[0] is the guest's own seat.
+type solverPlacement struct {
+	InviteID string
+	TableID  string
+	SeatIDs  []string
+}
+
+// solveSeating places parties at tables, largest parties first. Each party goes to the table with the
+// fewest free seats that still fits all of them (best fit), so tables fill up before new ones are opened
+// and large parties are not squeezed out by singles. Ties go to the earlier table. Parties that fit
+// nowhere are returned as unplaced. Input order is kept among parties of the same size.
+func solveSeating(tables []solverTable, parties []solverParty) (placed []solverPlacement, unplaced []solverParty) {
+	free := make([][]string, len(tables))
+	for i, t := range tables {
+		free[i] = append([]string(nil), t.FreeSeats...)
+	}
+	order := append([]solverParty(nil), parties...)
+	sort.SliceStable(order, func(i, j int) bool { return order[i].Size > order[j].Size })
+	for _, p := range order {
+		best := -1
+		for i := range tables {
+			if len(free[i]) < p.Size {
+				continue
+			}
+			if best == -1 || len(free[i]) < len(free[best]) {
+				best = i
+			}
+		}
+		if best == -1 {
+			unplaced = append(unplaced, p)
+			continue
+		}
+		placed = append(placed, solverPlacement{
+			InviteID: p.InviteID,
+			TableID:  tables[best].ID,
+			SeatIDs:  free[best][:p.Size:p.Size],
+		})
+		free[best] = free[best][p.Size:]
+	}
+	return placed, unplaced
+}
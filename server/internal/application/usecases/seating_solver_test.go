@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSolveSeating(t *testing.T) {
+	tests := []struct {
+		name         string
+		tables       []solverTable
+		parties      []solverParty
+		wantPlaced   []solverPlacement
+		wantUnplaced []solverParty
+	}{
+		{
+			name: "largest parties first, best fit",
+			tables: []solverTable{
+				{ID: "A", FreeSeats: []string{"a1", "a2", "a3", "a4"}},
+				{ID: "B", FreeSeats: []string{"b1", "b2", "b3", "b4", "b5", "b6"}},
+			},
+			parties: []solverParty{
+				{InviteID: "p1", Size: 1},
+				{InviteID: "p2", Size: 4},
+				{InviteID: "p3", Size: 2},
+				{InviteID: "p4", Size: 1},
+			},
+			wantPlaced: []solverPlacement{
+				{InviteID: "p2", TableID: "A", SeatIDs: []string{"a1", "a2", "a3", "a4"}},
+				{InviteID: "p3", TableID: "B", SeatIDs: []string{"b1", "b2"}},
+				{InviteID: "p1", TableID: "B", SeatIDs: []string{"b3"}},
+				{InviteID: "p4", TableID: "B", SeatIDs: []string{"b4"}},
+			},
+		},
+		{
+			name: "ties go to the earlier table",
+			tables: []solverTable{
+				{ID: "A", FreeSeats: []string{"a1", "a2"}},
+				{ID: "B", FreeSeats: []string{"b1", "b2"}},
+			},
+			parties:    []solverParty{{InviteID: "p1", Size: 1}},
+			wantPlaced: []solverPlacement{{InviteID: "p1", TableID: "A", SeatIDs: []string{"a1"}}},
+		},
+		{
+			name: "companions sit at the guest's table",
+			tables: []solverTable{
+				{ID: "A", FreeSeats: []string{"a1", "a2"}},
+				{ID: "B", FreeSeats: []string{"b1", "b2", "b3"}},
+			},
+			parties:    []solverParty{{InviteID: "p1", Size: 3}},
+			wantPlaced: []solverPlacement{{InviteID: "p1", TableID: "B", SeatIDs: []string{"b1", "b2", "b3"}}},
+		},
+		{
+			name: "a party is never split across tables",
+			tables: []solverTable{
+				{ID: "A", FreeSeats: []string{"a1", "a2"}},
+				{ID: "B", FreeSeats: []string{"b1", "b2"}},
+			},
+			parties:      []solverParty{{InviteID: "p1", Size: 3}},
+			wantUnplaced: []solverParty{{InviteID: "p1", Size: 3}},
+		},
+		{
+			name: "parties left over once tables are full",
+			tables: []solverTable{
+				{ID: "A", FreeSeats: []string{"a1", "a2"}},
+			},
+			parties: []solverParty{
+				{InviteID: "p1", Size: 2},
+				{InviteID: "p2", Size: 1},
+			},
+			wantPlaced:   []solverPlacement{{InviteID: "p1", TableID: "A", SeatIDs: []string{"a1", "a2"}}},
+			wantUnplaced: []solverParty{{InviteID: "p2", Size: 1}},
+		},
+		{
+			name:         "no tables",
+			parties:      []solverParty{{InviteID: "p1", Size: 1}},
+			wantUnplaced: []solverParty{{InviteID: "p1", Size: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			placed, unplaced := solveSeating(tt.tables, tt.parties)
+			if !reflect.DeepEqual(placed, tt.wantPlaced) {
+				t.Errorf("placed = %+v, want %+v", placed, tt.wantPlaced)
+			}
+			if !reflect.DeepEqual(unplaced, tt.wantUnplaced) {
+				t.Errorf("unplaced = %+v, want %+v", unplaced, tt.wantUnplaced)
+			}
+		})
+	}
+}
+
+func TestSolveSeatingKeepsInput(t *testing.T) {
+	tables := []solverTable{{ID: "A", FreeSeats: []string{"a1", "a2"}}}
+	parties := []solverParty{{InviteID: "p1", Size: 1}, {InviteID: "p2", Size: 2}}
+	solveSeating(tables, parties)
+	if len(tables[0].FreeSeats) != 2 || parties[0].InviteID != "p1" {
+		t.Errorf("solveSeating modified its input: tables %+v, parties %+v", tables, parties)
+	}
+}
@@ -0,0 +1,281 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+)
+
+// VenueLayoutUseCase saves an event's floor plan as a reusable layout and builds other events from it.
+// Layouts are private to the user who saved them.
+type VenueLayoutUseCase struct {
+	eventRepo        repositories.EventRepository
+	eventRoomRepo    repositories.EventRoomRepository
+	eventTableRepo   repositories.EventTableRepository
+	eventSeatRepo    repositories.EventSeatRepository
+	floorElementRepo repositories.FloorElementRepository
+	layoutRepo       repositories.VenueLayoutRepository
+	transactor       repositories.Transactor
+	eventUseCase     *EventUseCase
+}
+
+func NewVenueLayoutUseCase(
+	eventRepo repositories.EventRepository,
+	eventRoomRepo repositories.EventRoomRepository,
+	eventTableRepo repositories.EventTableRepository,
+	eventSeatRepo repositories.EventSeatRepository,
+	floorElementRepo repositories.FloorElementRepository,
+	layoutRepo repositories.VenueLayoutRepository,
+	transactor repositories.Transactor,
+	eventUseCase *EventUseCase,
+) *VenueLayoutUseCase {
+	return &VenueLayoutUseCase{
+		eventRepo:        eventRepo,
+		eventRoomRepo:    eventRoomRepo,
+		eventTableRepo:   eventTableRepo,
+		eventSeatRepo:    eventSeatRepo,
+		floorElementRepo: floorElementRepo,
+		layoutRepo:       layoutRepo,
+		transactor:       transactor,
+		eventUseCase:     eventUseCase,
+	}
+}
+
+func (uc *VenueLayoutUseCase) ListLayouts(ctx context.Context, ownerID string) ([]*dto.VenueLayoutResponse, error) {
+	list, err := uc.layoutRepo.ListByOwnerID(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*dto.VenueLayoutResponse, len(list))
+	for i, l := range list {
+		out[i] = toVenueLayoutResponse(l, false)
+	}
+	return out, nil
+}
+
+func (uc *VenueLayoutUseCase) GetLayout(ctx context.Context, ownerID, layoutID string) (*dto.VenueLayoutResponse, error) {
+	l, err := uc.findLayout(ctx, ownerID, layoutID)
+	if err != nil {
+		return nil, err
+	}
+	return toVenueLayoutResponse(l, true), nil
+}
+
+// SaveEventLayout copies the event's rooms, tables, seats and floor elements into a new layout. Guests,
+// reservations and holds are not part of a layout. Owner only.
+func (uc *VenueLayoutUseCase) SaveEventLayout(ctx context.Context, ownerID, eventID string, req dto.SaveLayoutRequest) (*dto.VenueLayoutResponse, error) {
+	if _, err := uc.eventUseCase.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	data, err := uc.eventUseCase.floorPlanData(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Tables) == 0 {
+		return nil, errors.New("event has no tables to save")
+	}
+	now := time.Now()
+	l := &entities.VenueLayout{
+		OwnerID:     ownerID,
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Data:        data,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.layoutRepo.Create(ctx, l); err != nil {
+		return nil, err
+	}
+	return toVenueLayoutResponse(l, true), nil
+}
+
+func (uc *VenueLayoutUseCase) UpdateLayout(ctx context.Context, ownerID, layoutID string, req dto.UpdateLayoutRequest) (*dto.VenueLayoutResponse, error) {
+	l, err := uc.findLayout(ctx, ownerID, layoutID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil {
+		l.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Description != nil {
+		l.Description = strings.TrimSpace(*req.Description)
+	}
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	l.UpdatedAt = time.Now()
+	if err := uc.layoutRepo.Update(ctx, l); err != nil {
+		return nil, err
+	}
+	return toVenueLayoutResponse(l, false), nil
+}
+
+func (uc *VenueLayoutUseCase) DeleteLayout(ctx context.Context, ownerID, layoutID string) error {
+	l, err := uc.findLayout(ctx, ownerID, layoutID)
+	if err != nil {
+		return err
+	}
+	return uc.layoutRepo.Delete(ctx, l)
+}
+
+// ApplyLayout creates the layout's rooms, tables, seats and floor elements on the event in one transaction.
+// Existing tables and elements are only replaced with req.Replace, and never while a guest has a seat.
+// The event's rooms are always replaced by the layout's.
+func (uc *VenueLayoutUseCase) ApplyLayout(ctx context.Context, ownerID, eventID, layoutID string, req dto.ApplyLayoutRequest) (*dto.EventSeatingResponse, error) {
+	if _, err := uc.eventUseCase.findOwnedEvent(ctx, ownerID, eventID); err != nil {
+		return nil, err
+	}
+	l, err := uc.findLayout(ctx, ownerID, layoutID)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.eventUseCase.withSeatingSnapshot(ctx, eventID, ownerID, snapshotLayoutApplied, func(ctx context.Context) error {
+		if err := uc.clearEventFloor(ctx, eventID, req.Replace); err != nil {
+			return err
+		}
+		return uc.eventUseCase.buildFloorPlan(ctx, eventID, l.Data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.eventUseCase.notifySeatingChanged(ctx, eventID)
+	return uc.eventUseCase.ListEventSeating(ctx, eventID, ownerID)
+}
+
+// clearEventFloor removes the event's rooms with their tables and floor elements. Without replace it only
+// removes rooms that are empty. Call it inside a transaction.
+func (uc *VenueLayoutUseCase) clearEventFloor(ctx context.Context, eventID string, replace bool) error {
+	tables, err := uc.eventTableRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	elements, err := uc.floorElementRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if len(tables) == 0 && len(elements) == 0 {
+		return uc.deleteEventRooms(ctx, eventID)
+	}
+	if !replace {
+		return errors.New("event already has a floor plan; set replace to overwrite it")
+	}
+	assignments, err := uc.eventSeatRepo.ListAssignmentsByEventID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if len(assignments) > 0 {
+		var inviteIDs []string
+		seen := make(map[string]bool)
+		for _, a := range assignments {
+			if !seen[a.InviteID] {
+				seen[a.InviteID] = true
+				inviteIDs = append(inviteIDs, a.InviteID)
+			}
+		}
+		invites, err := uc.eventUseCase.invitesByID(ctx, inviteIDs)
+		if err != nil {
+			return err
+		}
+		return &DisplacedGuestsError{Invites: invites}
+	}
+	for _, t := range tables {
+		if err := uc.eventSeatRepo.DeleteByTableID(ctx, t.ID); err != nil {
+			return err
+		}
+		if err := uc.eventTableRepo.Delete(ctx, t); err != nil {
+			return err
+		}
+	}
+	for _, e := range elements {
+		if err := uc.floorElementRepo.Delete(ctx, e); err != nil {
+			return err
+		}
+	}
+	return uc.deleteEventRooms(ctx, eventID)
+}
+
+func (uc *VenueLayoutUseCase) deleteEventRooms(ctx context.Context, eventID string) error {
+	rooms, err := uc.eventRoomRepo.ListByEventID(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	for _, room := range rooms {
+		if err := uc.eventRoomRepo.Delete(ctx, room); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (uc *VenueLayoutUseCase) findLayout(ctx context.Context, ownerID, layoutID string) (*entities.VenueLayout, error) {
+	l, err := uc.layoutRepo.FindByID(ctx, layoutID)
+	if err != nil || l.OwnerID != ownerID {
+		return nil, errors.New("layout not found")
+	}
+	return l, nil
+}
+
+func toVenueLayoutResponse(l *entities.VenueLayout, withData bool) *dto.VenueLayoutResponse {
+	resp := &dto.VenueLayoutResponse{
+		ID:          l.ID,
+		Name:        l.Name,
+		Description: l.Description,
+		TableCount:  len(l.Data.Tables),
+		CreatedAt:   l.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   l.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, t := range l.Data.Tables {
+		resp.SeatCount += len(t.Seats)
+	}
+	if !withData {
+		return resp
+	}
+	resp.Rooms = make([]*dto.LayoutRoomResponse, len(l.Data.Rooms))
+	for i, r := range l.Data.Rooms {
+		resp.Rooms[i] = &dto.LayoutRoomResponse{Name: r.Name, Width: r.Width, Height: r.Height}
+	}
+	resp.Tables = make([]*dto.LayoutTableResponse, len(l.Data.Tables))
+	for i, t := range l.Data.Tables {
+		seats := make([]*dto.LayoutSeatResponse, len(t.Seats))
+		for j, s := range t.Seats {
+			seats[j] = &dto.LayoutSeatResponse{Label: s.Label, Accessible: s.Accessible, VIP: s.VIP, Blocked: s.Blocked}
+		}
+		resp.Tables[i] = &dto.LayoutTableResponse{
+			Room:            t.Room,
+			Name:            t.Name,
+			Shape:           t.Shape,
+			Rows:            t.TableRows,
+			Columns:         t.TableColumns,
+			Capacity:        t.Capacity,
+			SeatLabelScheme: t.SeatLabelScheme,
+			PositionX:       t.PositionX,
+			PositionY:       t.PositionY,
+			Width:           t.Width,
+			Height:          t.Height,
+			Rotation:        t.Rotation,
+			Seats:           seats,
+		}
+	}
+	resp.Elements = make([]*dto.LayoutElementResponse, len(l.Data.Elements))
+	for i, e := range l.Data.Elements {
+		resp.Elements[i] = &dto.LayoutElementResponse{
+			Room:      e.Room,
+			Kind:      string(e.Kind),
+			Label:     e.Label,
+			PositionX: e.PositionX,
+			PositionY: e.PositionY,
+			Width:     e.Width,
+			Height:    e.Height,
+			Rotation:  e.Rotation,
+		}
+	}
+	return resp
+}
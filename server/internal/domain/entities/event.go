@@ -67,6 +67,13 @@ type Event struct {
 	EventDate  time.Time    `json:"event_date"`
 	StartTime  TimeOfDay    `json:"start_time"`
 	EndTime    TimeOfDay    `json:"end_time"`
+	Timezone   string       `json:"timezone"` // IANA name; start and end are wall-clock times in this zone
+	Capacity   *int         `json:"capacity,omitempty"` // max attendees, companions included; nil means no limit
+	RSVPDeadline          *time.Time `json:"rsvp_deadline,omitempty"`            // no new confirmations after this
+	SeatSelectionOpensAt  *time.Time `json:"seat_selection_opens_at,omitempty"`  // guests may pick seats from...
+	SeatSelectionClosesAt *time.Time `json:"seat_selection_closes_at,omitempty"` // ...until this
+	SeatingLocked         bool       `json:"seating_locked"`                     // freezes guest seat changes
+	CalendarSequence      int        `json:"-"`                                  // iCalendar SEQUENCE, raised on each update sent to guests
 	CreatedAt  time.Time    `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
@@ -94,6 +101,12 @@ func (e *Event) Validate() error {
 	if e.EndTime == "" {
 		return errors.ErrInvalidEndTime
 	}
+	if e.Timezone == "" || e.Timezone == "Local" {
+		return errors.ErrInvalidTimezone
+	}
+	if _, err := time.LoadLocation(e.Timezone); err != nil {
+		return errors.ErrInvalidTimezone
+	}
 	if e.OwnerID == "" {
 		return errors.ErrInvalidOwnerID
 	}
@@ -106,5 +119,61 @@ func (e *Event) Validate() error {
 	if e.Longitude == 0 {
 		return errors.ErrInvalidLongitude
 	}
+	if e.Capacity != nil && *e.Capacity < 1 {
+		return errors.ErrInvalidCapacity
+	}
+	if e.SeatSelectionOpensAt != nil && e.SeatSelectionClosesAt != nil && !e.SeatSelectionOpensAt.Before(*e.SeatSelectionClosesAt) {
+		return errors.ErrInvalidSeatSelectionWindow
+	}
 	return nil
-}
\ No newline at end of file
+}
+
+// Zone returns the event's time zone, or UTC when it is unset or unknown.
+func (e *Event) Zone() *time.Location {
+	if e.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// Schedule returns the instants the event starts and ends: its day and wall-clock times read in its
+// time zone. An end time at or before the start time falls on the next day.
+func (e *Event) Schedule() (start, end time.Time) {
+	loc := e.Zone()
+	y, m, d := e.EventDate.Date()
+	start = wallClock(y, m, d, e.StartTime.sinceMidnight(), loc)
+	end = wallClock(y, m, d, e.EndTime.sinceMidnight(), loc)
+	if !end.After(start) {
+		end = wallClock(y, m, d+1, e.EndTime.sinceMidnight(), loc)
+	}
+	return start, end
+}
+
+// HasEnded reports whether the event is over at now.
+func (e *Event) HasEnded(now time.Time) bool {
+	_, end := e.Schedule()
+	return !now.Before(end)
+}
+
+// wallClock is the instant the clocks in loc show the given day and time. Adding the time of day to
+// midnight would be off by an hour on days when daylight saving time starts or ends.
+func wallClock(y int, m time.Month, d int, sinceMidnight time.Duration, loc *time.Location) time.Time {
+	h := int(sinceMidnight / time.Hour)
+	mi := int(sinceMidnight % time.Hour / time.Minute)
+	sec := int(sinceMidnight % time.Minute / time.Second)
+	return time.Date(y, m, d, h, mi, sec, 0, loc)
+}
+
+// sinceMidnight parses "HH:MM[:SS]" as a duration from midnight, and is zero when it doesn't parse.
+func (t TimeOfDay) sinceMidnight() time.Duration {
+	for _, layout := range []string{"15:04:05", "15:04"} {
+		if c, err := time.Parse(layout, string(t)); err == nil {
+			return time.Duration(c.Hour())*time.Hour + time.Duration(c.Minute())*time.Minute + time.Duration(c.Second())*time.Second
+		}
+	}
+	return 0
+}
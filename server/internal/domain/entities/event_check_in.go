@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+// EventCheckIn records that a member of an invite's party arrived at the event: the guest holding the
+// ticket when CompanionID is nil, otherwise that companion.
+type EventCheckIn struct {
+	ID          string    `json:"id"`
+	EventID     string    `json:"event_id"`
+	InviteID    string    `json:"invite_id"`
+	CompanionID *string   `json:"companion_id,omitempty"`
+	CheckedInBy *string   `json:"checked_in_by,omitempty"` // the organizer who scanned the ticket
+	CheckedInAt time.Time `json:"checked_in_at"`
+}
@@ -6,16 +6,28 @@ import (
 	"github.com/KalebAsratemedhin/seatmaster/pkg/errors"
 )
 
+const (
+	// DefaultPartySize lets a guest bring one companion, as invites did before party sizes existed.
+	DefaultPartySize = 2
+	MaxPartySize     = 20
+)
+
 type EventInvite struct {
-	ID          string    `json:"id"`
-	EventID     string    `json:"event_id"`
-	UserID      *string   `json:"user_id,omitempty"` // nil when invited by email only (no account yet)
-	Email       string    `json:"email"`
-	Status      string    `json:"status"`
-	SeatID      *string   `json:"seat_id,omitempty"`
-	GuestSeatID *string   `json:"guest_seat_id,omitempty"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID           string     `json:"id"`
+	EventID      string     `json:"event_id"`
+	UserID       *string    `json:"user_id,omitempty"` // nil when invited by email only (no account yet)
+	Email        string     `json:"email"`
+	Status       string     `json:"status"`
+	SeatID       *string    `json:"seat_id,omitempty"`
+	PartySize    int        `json:"party_size"`              // the guest plus the companions the organizer allows
+	WaitlistedAt *time.Time `json:"waitlisted_at,omitempty"` // set while Status is "waitlisted"; orders the waitlist
+	// CalendarInvited is set once the guest was emailed a calendar invitation for the event.
+	CalendarInvited bool      `json:"-"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+
+	// Companions are loaded and saved by the invite repository together with the invite.
+	Companions []*EventInviteCompanion `json:"companions,omitempty" gorm:"-"`
 }
 
 func (e *EventInvite) Validate() error {
@@ -28,5 +40,16 @@ func (e *EventInvite) Validate() error {
 	if e.Status == "" {
 		return errors.ErrInvalidInviteStatus
 	}
+	if e.PartySize < 1 || e.PartySize > MaxPartySize {
+		return errors.ErrInvalidPartySize
+	}
+	if 1+len(e.Companions) > e.PartySize {
+		return errors.ErrPartyTooLarge
+	}
+	for _, c := range e.Companions {
+		if err := c.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
-}
\ No newline at end of file
+}
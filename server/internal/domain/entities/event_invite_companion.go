@@ -0,0 +1,27 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// EventInviteCompanion is someone the invited guest brings along. Each companion takes one place of the
+// invite's party size and may have a seat of their own.
+type EventInviteCompanion struct {
+	ID           string    `json:"id"`
+	InviteID     string    `json:"invite_id"`
+	Name         string    `json:"name"`
+	SeatID       *string   `json:"seat_id,omitempty"`
+	DisplayOrder int       `json:"display_order"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (c *EventInviteCompanion) Validate() error {
+	if strings.TrimSpace(c.Name) == "" {
+		return errors.ErrInvalidCompanionName
+	}
+	return nil
+}
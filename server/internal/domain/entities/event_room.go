@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// EventRoom is a room or floor of an event venue. Tables and floor elements belong to a room and their
+// positions and sizes are percentages of that room's floor. Width and Height are unitless and only give
+// the floor's proportions, so the floor chart can keep its aspect ratio.
+type EventRoom struct {
+	ID           string    `json:"id"`
+	EventID      string    `json:"event_id"`
+	Name         string    `json:"name"`
+	Width        float64   `json:"width"`  // relative to Height
+	Height       float64   `json:"height"` // relative to Width
+	DisplayOrder int       `json:"display_order"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (r *EventRoom) Validate() error {
+	if r.EventID == "" {
+		return errors.ErrInvalidEventID
+	}
+	if r.Name == "" {
+		return errors.ErrInvalidName
+	}
+	if r.Width <= 0 || r.Width > 1000 || r.Height <= 0 || r.Height > 1000 {
+		return errors.ErrInvalidRoomSize
+	}
+	return nil
+}
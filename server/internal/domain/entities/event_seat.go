@@ -3,10 +3,14 @@ package entities
 import "time"
 
 type EventSeat struct {
-	ID           string    `json:"id"`
-	EventTableID string    `json:"event_table_id"`
-	Label        string    `json:"label"`
-	DisplayOrder int       `json:"display_order"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID               string    `json:"id"`
+	EventTableID     string    `json:"event_table_id"`
+	Label            string    `json:"label"`
+	DisplayOrder     int       `json:"display_order"`
+	Accessible       bool      `json:"accessible"` // wheelchair-accessible
+	VIP              bool      `json:"vip"`
+	Blocked          bool      `json:"blocked"`                      // not selectable by anyone
+	ReservedInviteID *string   `json:"reserved_invite_id,omitempty"` // only this invite may pick the seat
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+// EventSeatAssignment records which invite occupies a seat. A seat has at most one assignment;
+// CompanionID is set when the seat is taken by one of the invite's companions.
+type EventSeatAssignment struct {
+	ID          string    `json:"id"`
+	EventID     string    `json:"event_id"`
+	SeatID      string    `json:"seat_id"`
+	InviteID    string    `json:"invite_id"`
+	CompanionID *string   `json:"companion_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,14 @@
+package entities
+
+import "time"
+
+// EventSeatHold reserves a seat for a user for a short time while they finish their RSVP.
+// A hold is ignored once ExpiresAt has passed and is removed by the expiry loop.
+type EventSeatHold struct {
+	ID        string    `json:"id"`
+	EventID   string    `json:"event_id"`
+	SeatID    string    `json:"seat_id"`
+	UserID    string    `json:"user_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -2,17 +2,30 @@ package entities
 
 import "time"
 
+// Seat labeling schemes for EventTable.SeatLabelScheme.
+const (
+	SeatLabelNumeric   = "numeric"    // 1, 2, 3 ...
+	SeatLabelRowLetter = "row_letter" // A1, A2 ... B1; grids use one letter per row
+	SeatLabelCustom    = "custom"     // taken from EventTable.SeatLabels in seat order
+)
+
 type EventTable struct {
-	ID           string    `json:"id"`
-	EventID      string    `json:"event_id"`
-	Name         string    `json:"name"`   // Auto-set to "Table 1", "Table 2", etc.
-	Shape        string    `json:"shape"`  // "round", "rectangular", or "grid"
-	TableRows    *int      `json:"table_rows,omitempty"`    // for grid
-	TableColumns *int      `json:"table_columns,omitempty"` // for grid
-	Capacity     int       `json:"capacity"`
-	DisplayOrder int       `json:"display_order"`
-	PositionX    float64   `json:"position_x"` // 0-100, percentage on floor
-	PositionY    float64   `json:"position_y"` // 0-100, percentage on floor
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID              string     `json:"id"`
+	EventID         string     `json:"event_id"`
+	RoomID          string     `json:"room_id"`
+	Name            string     `json:"name"`                    // Defaults to "Table 1", "Table 2", etc.
+	Shape           string     `json:"shape"`                   // "round", "rectangular", or "grid"
+	TableRows       *int       `json:"table_rows,omitempty"`    // for grid
+	TableColumns    *int       `json:"table_columns,omitempty"` // for grid
+	Capacity        int        `json:"capacity"`
+	SeatLabelScheme string     `json:"seat_label_scheme"`
+	SeatLabels      StringList `json:"seat_labels"` // used by the custom scheme
+	DisplayOrder    int        `json:"display_order"`
+	PositionX       float64    `json:"position_x"` // 0-100, percentage on floor
+	PositionY       float64    `json:"position_y"` // 0-100, percentage on floor
+	Width           float64    `json:"width"`      // 0-100, percentage of floor width
+	Height          float64    `json:"height"`     // 0-100, percentage of floor height
+	Rotation        float64    `json:"rotation"`   // degrees clockwise, 0-360
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
 }
@@ -0,0 +1,85 @@
+package entities
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestEventSchedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		timezone  string
+		date      string
+		start     TimeOfDay
+		end       TimeOfDay
+		wantStart string // RFC 3339 in UTC
+		wantEnd   string
+	}{
+		{"UTC", "UTC", "2026-06-01", "18:00:00", "22:00:00", "2026-06-01T18:00:00Z", "2026-06-01T22:00:00Z"},
+		{"empty zone is UTC", "", "2026-06-01", "18:00:00", "22:00:00", "2026-06-01T18:00:00Z", "2026-06-01T22:00:00Z"},
+		{"fixed offset", "Africa/Addis_Ababa", "2026-06-01", "18:00:00", "22:00:00", "2026-06-01T15:00:00Z", "2026-06-01T19:00:00Z"},
+		{"ends after midnight", "Africa/Addis_Ababa", "2026-06-01", "22:00:00", "01:00:00", "2026-06-01T19:00:00Z", "2026-06-01T22:00:00Z"},
+		{"end equal to start is the next day", "UTC", "2026-06-01", "09:00:00", "09:00:00", "2026-06-01T09:00:00Z", "2026-06-02T09:00:00Z"},
+		{"short time format", "UTC", "2026-06-01", "09:30", "10:45", "2026-06-01T09:30:00Z", "2026-06-01T10:45:00Z"},
+		// Clocks go from 02:00 to 03:00 on 29 March 2026 in Berlin: 01:00-04:00 lasts two hours.
+		{"daylight saving starts", "Europe/Berlin", "2026-03-29", "01:00:00", "04:00:00", "2026-03-29T00:00:00Z", "2026-03-29T02:00:00Z"},
+		{"evening after daylight saving starts", "Europe/Berlin", "2026-03-29", "18:00:00", "22:00:00", "2026-03-29T16:00:00Z", "2026-03-29T20:00:00Z"},
+		// Clocks go from 03:00 back to 02:00 on 25 October 2026 in Berlin: 01:00-04:00 lasts four hours.
+		{"daylight saving ends", "Europe/Berlin", "2026-10-25", "01:00:00", "04:00:00", "2026-10-24T23:00:00Z", "2026-10-25T03:00:00Z"},
+		{"overnight across the change", "Europe/Berlin", "2026-10-24", "22:00:00", "06:00:00", "2026-10-24T20:00:00Z", "2026-10-25T05:00:00Z"},
+		{"New York spring forward", "America/New_York", "2026-03-08", "00:00:00", "12:00:00", "2026-03-08T05:00:00Z", "2026-03-08T16:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, err := time.Parse("2006-01-02", tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			e := &Event{EventDate: date, StartTime: tt.start, EndTime: tt.end, Timezone: tt.timezone}
+			start, end := e.Schedule()
+			if got := start.UTC().Format(time.RFC3339); got != tt.wantStart {
+				t.Errorf("start = %s, want %s", got, tt.wantStart)
+			}
+			if got := end.UTC().Format(time.RFC3339); got != tt.wantEnd {
+				t.Errorf("end = %s, want %s", got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestEventHasEnded(t *testing.T) {
+	date := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	// 18:00-22:00 in Addis Ababa (UTC+3) ends at 19:00 UTC.
+	e := &Event{EventDate: date, StartTime: "18:00:00", EndTime: "22:00:00", Timezone: "Africa/Addis_Ababa"}
+	tests := []struct {
+		now  time.Time
+		want bool
+	}{
+		{time.Date(2026, 6, 1, 18, 59, 59, 0, time.UTC), false},
+		{time.Date(2026, 6, 1, 19, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, 6, 1, 21, 0, 0, 0, time.UTC), true},
+		{time.Date(2026, 5, 31, 23, 0, 0, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		if got := e.HasEnded(tt.now); got != tt.want {
+			t.Errorf("HasEnded(%s) = %v, want %v", tt.now.Format(time.RFC3339), got, tt.want)
+		}
+	}
+}
+
+func TestEventZone(t *testing.T) {
+	tests := []struct {
+		timezone string
+		want     string
+	}{
+		{"", "UTC"},
+		{"Europe/Berlin", "Europe/Berlin"},
+		{"Not/AZone", "UTC"},
+	}
+	for _, tt := range tests {
+		if got := (&Event{Timezone: tt.timezone}).Zone().String(); got != tt.want {
+			t.Errorf("Zone(%q) = %s, want %s", tt.timezone, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+type FloorElementKind string
+
+const (
+	FloorElementStage      FloorElementKind = "stage"
+	FloorElementDanceFloor FloorElementKind = "dance_floor"
+	FloorElementBar        FloorElementKind = "bar"
+	FloorElementEntrance   FloorElementKind = "entrance"
+	FloorElementBuffet     FloorElementKind = "buffet"
+	FloorElementRestroom   FloorElementKind = "restroom"
+)
+
+// FloorElement is a non-seating object on a room's floor plan (stage, bar, ...). Position and size
+// use the same 0-100 percentage space as EventTable positions; the position is the element's center.
+type FloorElement struct {
+	ID        string           `json:"id"`
+	EventID   string           `json:"event_id"`
+	RoomID    string           `json:"room_id"`
+	Kind      FloorElementKind `json:"kind"`
+	Label     string           `json:"label"`
+	PositionX float64          `json:"position_x"`
+	PositionY float64          `json:"position_y"`
+	Width     float64          `json:"width"`
+	Height    float64          `json:"height"`
+	Rotation  float64          `json:"rotation"` // degrees clockwise, 0-360
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+// IsValid reports whether k is a known element kind.
+func (k FloorElementKind) IsValid() bool {
+	switch k {
+	case FloorElementStage, FloorElementDanceFloor, FloorElementBar, FloorElementEntrance, FloorElementBuffet, FloorElementRestroom:
+		return true
+	}
+	return false
+}
+
+func (e *FloorElement) Validate() error {
+	if e.EventID == "" {
+		return errors.ErrInvalidEventID
+	}
+	if !e.Kind.IsValid() {
+		return errors.ErrInvalidFloorElementKind
+	}
+	if e.PositionX < 0 || e.PositionX > 100 || e.PositionY < 0 || e.PositionY > 100 {
+		return errors.ErrInvalidFloorPosition
+	}
+	if e.Width <= 0 || e.Width > 100 || e.Height <= 0 || e.Height > 100 {
+		return errors.ErrInvalidFloorElementSize
+	}
+	return nil
+}
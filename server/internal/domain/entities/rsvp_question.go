@@ -0,0 +1,106 @@
+package entities
+
+import (
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+type RSVPQuestionKind string
+
+const (
+	RSVPQuestionText         RSVPQuestionKind = "text"
+	RSVPQuestionSingleChoice RSVPQuestionKind = "single_choice"
+	RSVPQuestionMultiChoice  RSVPQuestionKind = "multi_choice"
+
+	// MaxRSVPAnswerLength caps free-text answers.
+	MaxRSVPAnswerLength = 2000
+)
+
+// RSVPQuestion is a question the organizer asks on the event's RSVP form, such as a meal choice or a
+// song request. PerCompanion questions are answered by the guest and by each of their companions.
+type RSVPQuestion struct {
+	ID           string           `json:"id"`
+	EventID      string           `json:"event_id"`
+	Prompt       string           `json:"prompt"`
+	Kind         RSVPQuestionKind `json:"kind"`
+	Options      StringList       `json:"options"` // the choices of single_choice and multi_choice questions
+	Required     bool             `json:"required"`
+	PerCompanion bool             `json:"per_companion"`
+	DisplayOrder int              `json:"display_order"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+func (q *RSVPQuestion) Validate() error {
+	if q.EventID == "" {
+		return errors.ErrInvalidEventID
+	}
+	if strings.TrimSpace(q.Prompt) == "" {
+		return errors.ErrInvalidQuestionPrompt
+	}
+	switch q.Kind {
+	case RSVPQuestionText:
+		if len(q.Options) > 0 {
+			return errors.ErrInvalidQuestionOptions
+		}
+	case RSVPQuestionSingleChoice, RSVPQuestionMultiChoice:
+		if len(q.Options) < 2 {
+			return errors.ErrInvalidQuestionOptions
+		}
+		seen := make(map[string]bool, len(q.Options))
+		for _, o := range q.Options {
+			if strings.TrimSpace(o) == "" || seen[o] {
+				return errors.ErrInvalidQuestionOptions
+			}
+			seen[o] = true
+		}
+	default:
+		return errors.ErrInvalidQuestionKind
+	}
+	return nil
+}
+
+// CheckAnswer reports whether the answer fits the question: free text for text questions, exactly one
+// of the options for single_choice and one or more distinct options for multi_choice.
+func (q *RSVPQuestion) CheckAnswer(a *RSVPAnswer) error {
+	if q.Kind == RSVPQuestionText {
+		if len(a.Choices) > 0 || len(a.Text) > MaxRSVPAnswerLength {
+			return errors.ErrInvalidRSVPAnswer
+		}
+		return nil
+	}
+	if a.Text != "" || len(a.Choices) == 0 || (q.Kind == RSVPQuestionSingleChoice && len(a.Choices) > 1) {
+		return errors.ErrInvalidRSVPAnswer
+	}
+	picked := make(map[string]bool, len(a.Choices))
+	for _, c := range a.Choices {
+		if picked[c] || !q.hasOption(c) {
+			return errors.ErrInvalidRSVPAnswer
+		}
+		picked[c] = true
+	}
+	return nil
+}
+
+func (q *RSVPQuestion) hasOption(option string) bool {
+	for _, o := range q.Options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+// RSVPAnswer is one party member's answer to an RSVP question: the guest's own when CompanionID is nil.
+type RSVPAnswer struct {
+	ID          string     `json:"id"`
+	QuestionID  string     `json:"question_id"`
+	InviteID    string     `json:"invite_id"`
+	CompanionID *string    `json:"companion_id,omitempty"`
+	Text        string     `json:"text"`    // text questions
+	Choices     StringList `json:"choices"` // choice questions
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+type SeatingConstraintKind string
+
+const (
+	// SeatingConstraintTogether means both guests should sit at the same table.
+	SeatingConstraintTogether SeatingConstraintKind = "together"
+	// SeatingConstraintApart means the guests must never share a table.
+	SeatingConstraintApart SeatingConstraintKind = "apart"
+)
+
+// SeatingConstraint is an organizer's rule between two invites of the same event.
+type SeatingConstraint struct {
+	ID             string                `json:"id"`
+	EventID        string                `json:"event_id"`
+	Kind           SeatingConstraintKind `json:"kind"`
+	FirstInviteID  string                `json:"first_invite_id"`
+	SecondInviteID string                `json:"second_invite_id"`
+	Note           string                `json:"note"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+func (c *SeatingConstraint) Validate() error {
+	if c.EventID == "" {
+		return errors.ErrInvalidEventID
+	}
+	if c.Kind != SeatingConstraintTogether && c.Kind != SeatingConstraintApart {
+		return errors.ErrInvalidConstraintKind
+	}
+	if c.FirstInviteID == "" || c.SecondInviteID == "" || c.FirstInviteID == c.SecondInviteID {
+		return errors.ErrInvalidConstraintInvites
+	}
+	return nil
+}
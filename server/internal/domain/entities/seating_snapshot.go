@@ -0,0 +1,54 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SeatingSnapshot is a copy of an event's whole seating state taken after a change to it, so an owner can
+// see what changed and go back to an earlier version.
+type SeatingSnapshot struct {
+	ID        string              `json:"id"`
+	EventID   string              `json:"event_id"`
+	Reason    string              `json:"reason"`     // the change that produced it, e.g. "table_deleted"
+	CreatedBy *string             `json:"created_by"` // user who made the change; nil for system changes
+	Data      SeatingSnapshotData `json:"data"`
+	CreatedAt time.Time           `json:"created_at"`
+}
+
+// SeatingSnapshotData holds the rows of the seating state with their IDs, so a restore brings back the
+// same rooms, tables and seats that invites and reservations refer to. It implements sql.Scanner and
+// driver.Valuer for the JSONB column.
+type SeatingSnapshotData struct {
+	Rooms       []EventRoom           `json:"rooms"`
+	Tables      []EventTable          `json:"tables"`
+	Seats       []EventSeat           `json:"seats"`
+	Elements    []FloorElement        `json:"elements"`
+	Assignments []EventSeatAssignment `json:"assignments"`
+}
+
+func (d *SeatingSnapshotData) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*d = SeatingSnapshotData{}
+		return nil
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fmt.Errorf("cannot scan %T into SeatingSnapshotData", value)
+	}
+	return json.Unmarshal(data, d)
+}
+
+func (d SeatingSnapshotData) Value() (driver.Value, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
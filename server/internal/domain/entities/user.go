@@ -14,6 +14,7 @@ type User struct {
 	LastName  string    `json:"last_name"`
 	Phone     string    `json:"phone"`
 	AvatarURL string    `json:"avatar_url"`
+	CalendarToken *string `json:"-"` // secret in the calendar feed URL; nil until first requested
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
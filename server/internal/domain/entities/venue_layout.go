@@ -0,0 +1,110 @@
+package entities
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// VenueLayout is a reusable floor plan owned by a user: the tables, seats and floor elements of a hall,
+// saved from one event and applied to others.
+type VenueLayout struct {
+	ID          string     `json:"id"`
+	OwnerID     string     `json:"owner_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Data        LayoutData `json:"data"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func (l *VenueLayout) Validate() error {
+	if l.OwnerID == "" {
+		return errors.ErrInvalidOwnerID
+	}
+	if l.Name == "" {
+		return errors.ErrInvalidName
+	}
+	return nil
+}
+
+// LayoutData is the floor plan stored in a layout's JSONB column. It implements sql.Scanner and driver.Valuer.
+// Tables and elements refer to their room by index into Rooms; layouts saved before rooms existed have
+// no Rooms and are placed in a single default room.
+type LayoutData struct {
+	Rooms    []LayoutRoom    `json:"rooms,omitempty"`
+	Tables   []LayoutTable   `json:"tables"`
+	Elements []LayoutElement `json:"elements"`
+}
+
+type LayoutRoom struct {
+	Name         string  `json:"name"`
+	Width        float64 `json:"width"`
+	Height       float64 `json:"height"`
+	DisplayOrder int     `json:"display_order"`
+}
+
+// LayoutTable is a table of a layout, without event-specific data such as reservations.
+type LayoutTable struct {
+	Room            int          `json:"room"`
+	Name            string       `json:"name"`
+	Shape           string       `json:"shape"`
+	TableRows       *int         `json:"table_rows,omitempty"`
+	TableColumns    *int         `json:"table_columns,omitempty"`
+	Capacity        int          `json:"capacity"`
+	SeatLabelScheme string       `json:"seat_label_scheme"`
+	SeatLabels      []string     `json:"seat_labels,omitempty"`
+	DisplayOrder    int          `json:"display_order"`
+	PositionX       float64      `json:"position_x"`
+	PositionY       float64      `json:"position_y"`
+	Width           float64      `json:"width"`
+	Height          float64      `json:"height"`
+	Rotation        float64      `json:"rotation"`
+	Seats           []LayoutSeat `json:"seats"`
+}
+
+type LayoutSeat struct {
+	Label        string `json:"label"`
+	DisplayOrder int    `json:"display_order"`
+	Accessible   bool   `json:"accessible"`
+	VIP          bool   `json:"vip"`
+	Blocked      bool   `json:"blocked"`
+}
+
+type LayoutElement struct {
+	Room      int              `json:"room"`
+	Kind      FloorElementKind `json:"kind"`
+	Label     string           `json:"label"`
+	PositionX float64          `json:"position_x"`
+	PositionY float64          `json:"position_y"`
+	Width     float64          `json:"width"`
+	Height    float64          `json:"height"`
+	Rotation  float64          `json:"rotation"`
+}
+
+func (d *LayoutData) Scan(value interface{}) error {
+	var data []byte
+	switch v := value.(type) {
+	case nil:
+		*d = LayoutData{}
+		return nil
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return fmt.Errorf("cannot scan %T into LayoutData", value)
+	}
+	return json.Unmarshal(data, d)
+}
+
+func (d LayoutData) Value() (driver.Value, error) {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
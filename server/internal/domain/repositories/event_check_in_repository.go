@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+type EventCheckInRepository interface {
+	// Create records the check-in and reports false, without error, when the party member has
+	// already checked in.
+	Create(ctx context.Context, c *entities.EventCheckIn) (bool, error)
+	ListByInviteID(ctx context.Context, inviteID string) ([]*entities.EventCheckIn, error)
+	// ListByEventID returns the event's check-ins, latest first.
+	ListByEventID(ctx context.Context, eventID string) ([]*entities.EventCheckIn, error)
+	Delete(ctx context.Context, c *entities.EventCheckIn) error
+}
@@ -6,8 +6,11 @@ import (
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
 )
 
+// EventInviteRepository stores invites together with their companions: every find loads them and
+// Create/Update replace them.
 type EventInviteRepository interface {
 	Create(ctx context.Context, invite *entities.EventInvite) error
+	FindByID(ctx context.Context, id string) (*entities.EventInvite, error)
 	ListByEventID(ctx context.Context, eventID string) ([]*entities.EventInvite, error)
 	ListByEventIDPaginated(ctx context.Context, eventID string, limit, offset int) ([]*entities.EventInvite, int64, error)
 	ListByUserID(ctx context.Context, userID string) ([]*entities.EventInvite, error)
@@ -20,5 +23,7 @@ type EventInviteRepository interface {
 	ListByUserIDOrEmailPaginated(ctx context.Context, userID string, email string, limit, offset int) ([]*entities.EventInvite, int64, error)
 	Update(ctx context.Context, invite *entities.EventInvite) error
 	ListRecentByOwnerID(ctx context.Context, ownerID string, limit int) ([]*entities.EventInvite, error)
-	CountByOwnerIDGroupByStatus(ctx context.Context, ownerID string) (total int64, confirmed int64, declined int64, pending int64, err error)
+	// CountByOwnerIDGroupByStatus counts heads rather than invites: every invite counts the guest and the
+	// companions they have named, whatever its status.
+	CountByOwnerIDGroupByStatus(ctx context.Context, ownerID string) (total int64, confirmed int64, declined int64, pending int64, waitlisted int64, err error)
 }
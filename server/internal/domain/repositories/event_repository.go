@@ -21,6 +21,10 @@ type EventRepository interface {
 	ExistsByName(ctx context.Context, name string) (bool, error)
 	Delete(ctx context.Context, event *entities.Event) error
 	Update(ctx context.Context, event *entities.Event) error
+	// LockByID locks the event row until the surrounding transaction ends, serializing RSVPs against
+	// its capacity and edits against each other, and returns the row as locked. Only meaningful inside
+	// a transaction.
+	LockByID(ctx context.Context, id string) (*entities.Event, error)
 	// ListPublic returns public events with optional search, for discovery (no auth).
 	ListPublic(ctx context.Context, search string, limit, offset int) ([]*entities.Event, error)
 }
\ No newline at end of file
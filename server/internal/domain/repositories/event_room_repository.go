@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+type EventRoomRepository interface {
+	Create(ctx context.Context, room *entities.EventRoom) error
+	FindByID(ctx context.Context, id string) (*entities.EventRoom, error)
+	ListByEventID(ctx context.Context, eventID string) ([]*entities.EventRoom, error)
+	Update(ctx context.Context, room *entities.EventRoom) error
+	Delete(ctx context.Context, room *entities.EventRoom) error
+}
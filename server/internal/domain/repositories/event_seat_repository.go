@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
 )
@@ -15,4 +16,16 @@ type EventSeatRepository interface {
 	Update(ctx context.Context, s *entities.EventSeat) error
 	Delete(ctx context.Context, s *entities.EventSeat) error
 	DeleteByTableID(ctx context.Context, eventTableID string) error
+	ListAssignmentsByEventID(ctx context.Context, eventID string) ([]*entities.EventSeatAssignment, error)
+	// ReplaceInviteAssignments swaps the invite's current seat assignments for the given ones.
+	// Returns errors.ErrSeatTaken if another invite already holds one of the seats.
+	ReplaceInviteAssignments(ctx context.Context, inviteID string, assignments []*entities.EventSeatAssignment) error
+	// LockByIDs takes row locks on the given seats until the surrounding transaction ends.
+	LockByIDs(ctx context.Context, ids []string) error
+	ListActiveHoldsByEventID(ctx context.Context, eventID string, now time.Time) ([]*entities.EventSeatHold, error)
+	// ReplaceUserHolds drops the user's holds for the event and any expired holds on the requested
+	// seats, then stores the new holds. Returns errors.ErrSeatHeld if a seat is still held by someone else.
+	ReplaceUserHolds(ctx context.Context, eventID, userID string, holds []*entities.EventSeatHold, now time.Time) error
+	DeleteUserHolds(ctx context.Context, eventID, userID string) error
+	DeleteExpiredHolds(ctx context.Context, now time.Time) (int64, error)
 }
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+type FloorElementRepository interface {
+	Create(ctx context.Context, e *entities.FloorElement) error
+	FindByID(ctx context.Context, id string) (*entities.FloorElement, error)
+	ListByEventID(ctx context.Context, eventID string) ([]*entities.FloorElement, error)
+	Update(ctx context.Context, e *entities.FloorElement) error
+	Delete(ctx context.Context, e *entities.FloorElement) error
+}
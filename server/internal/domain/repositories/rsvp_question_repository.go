@@ -0,0 +1,22 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+type RSVPQuestionRepository interface {
+	Create(ctx context.Context, q *entities.RSVPQuestion) error
+	FindByID(ctx context.Context, id string) (*entities.RSVPQuestion, error)
+	// ListByEventID returns the event's questions in display order.
+	ListByEventID(ctx context.Context, eventID string) ([]*entities.RSVPQuestion, error)
+	Update(ctx context.Context, q *entities.RSVPQuestion) error
+	// Delete removes the question with its answers.
+	Delete(ctx context.Context, q *entities.RSVPQuestion) error
+	CountAnswersByQuestionID(ctx context.Context, questionID string) (int64, error)
+	ListAnswersByInviteID(ctx context.Context, inviteID string) ([]*entities.RSVPAnswer, error)
+	ListAnswersByEventID(ctx context.Context, eventID string) ([]*entities.RSVPAnswer, error)
+	// ReplaceInviteAnswers swaps all answers of the invite's party for the given ones.
+	ReplaceInviteAnswers(ctx context.Context, inviteID string, answers []*entities.RSVPAnswer) error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+type SeatingConstraintRepository interface {
+	// Create stores a rule. Returns errors.ErrConstraintExists if the pair already has one.
+	Create(ctx context.Context, c *entities.SeatingConstraint) error
+	FindByID(ctx context.Context, id string) (*entities.SeatingConstraint, error)
+	ListByEventID(ctx context.Context, eventID string) ([]*entities.SeatingConstraint, error)
+	Update(ctx context.Context, c *entities.SeatingConstraint) error
+	Delete(ctx context.Context, c *entities.SeatingConstraint) error
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+type SeatingSnapshotRepository interface {
+	Create(ctx context.Context, s *entities.SeatingSnapshot) error
+	FindByID(ctx context.Context, id string) (*entities.SeatingSnapshot, error)
+	// ListByEventID returns the event's snapshots, newest first.
+	ListByEventID(ctx context.Context, eventID string, limit, offset int) ([]*entities.SeatingSnapshot, int64, error)
+	ExistsByEventID(ctx context.Context, eventID string) (bool, error)
+	// DeleteAllButLatest keeps the newest keep snapshots of the event whose reason is not exceptReason and
+	// deletes the rest of them. Snapshots with exceptReason are left alone.
+	DeleteAllButLatest(ctx context.Context, eventID string, keep int, exceptReason string) error
+	// DeleteAllButLatestWithReason keeps the newest keep snapshots of the event with the given reason and
+	// deletes the rest of them. Snapshots with other reasons are left alone.
+	DeleteAllButLatestWithReason(ctx context.Context, eventID, reason string, keep int) error
+}
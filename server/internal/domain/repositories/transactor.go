@@ -0,0 +1,9 @@
+package repositories
+
+import "context"
+
+// Transactor runs fn inside a database transaction. Repository calls made with the context
+// passed to fn join that transaction; it is committed when fn returns nil and rolled back otherwise.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
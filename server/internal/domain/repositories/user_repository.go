@@ -12,4 +12,6 @@ type UserRepository interface {
 	FindByID(ctx context.Context, id string) (*entities.User, error)
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 	Update(ctx context.Context, user *entities.User) error
+	FindByCalendarToken(ctx context.Context, token string) (*entities.User, error)
+	SetCalendarToken(ctx context.Context, userID, token string) error
 }
\ No newline at end of file
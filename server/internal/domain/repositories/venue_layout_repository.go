@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+)
+
+type VenueLayoutRepository interface {
+	Create(ctx context.Context, l *entities.VenueLayout) error
+	FindByID(ctx context.Context, id string) (*entities.VenueLayout, error)
+	ListByOwnerID(ctx context.Context, ownerID string) ([]*entities.VenueLayout, error)
+	Update(ctx context.Context, l *entities.VenueLayout) error
+	Delete(ctx context.Context, l *entities.VenueLayout) error
+}
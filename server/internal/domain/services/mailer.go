@@ -2,8 +2,24 @@ package services
 
 import "context"
 
+// CalendarPart is an iTIP message (RFC 5546) sent along with an email, so that mail clients offer to
+// add the event to the guest's calendar or update the copy already there.
+type CalendarPart struct {
+	Method string // REQUEST or CANCEL, matching the METHOD inside Data
+	Data   []byte // the encoded VCALENDAR
+}
+
 // Mailer sends emails. Implementations may use SMTP (e.g. Gmail), SendGrid, etc.
+// A nil *CalendarPart sends the email without one.
 type Mailer interface {
 	// SendInviteEmail sends an invitation email to the guest with a link to the event RSVP page.
-	SendInviteEmail(ctx context.Context, toEmail, eventName, rsvpURL string) error
+	SendInviteEmail(ctx context.Context, toEmail, eventName, rsvpURL string, invitation *CalendarPart) error
+	// SendWaitlistPromotionEmail tells a waitlisted guest that a spot opened up and their RSVP is confirmed.
+	SendWaitlistPromotionEmail(ctx context.Context, toEmail, eventName, rsvpURL string) error
+	// SendEventUpdatedEmail tells a guest who hasn't declined that the event's date, time or place changed.
+	SendEventUpdatedEmail(ctx context.Context, toEmail, eventName, eventURL string, update *CalendarPart) error
+	// SendEventCancelledEmail tells a guest who hasn't declined that the event was called off.
+	SendEventCancelledEmail(ctx context.Context, toEmail, eventName string, cancellation *CalendarPart) error
+	// SendRSVPDeclinedEmail confirms a guest's decline and removes the event from their calendar.
+	SendRSVPDeclinedEmail(ctx context.Context, toEmail, eventName string, cancellation *CalendarPart) error
 }
@@ -0,0 +1,32 @@
+package services
+
+import "context"
+
+// Seating event types pushed to clients watching an event's seating chart.
+const (
+	SeatingSeatClaimed     = "seat_claimed"     // SeatID now belongs to InviteID
+	SeatingSeatReleased    = "seat_released"    // SeatID is free again
+	SeatingTableAdded      = "table_added"      // Data is the new table
+	SeatingTableMoved      = "table_moved"      // Data is the table with its new position, size or rotation
+	SeatingTableUpdated    = "table_updated"    // Data is the table after a rename, resize or relabel
+	SeatingTableRemoved    = "table_removed"    // TableID no longer exists
+	SeatingTablesReordered = "tables_reordered" // TableIDs in their new display order
+	SeatingChanged         = "seating_changed"  // bulk change; clients should reload the seating chart
+)
+
+// SeatingEvent is a change to an event's seating chart.
+type SeatingEvent struct {
+	Type     string      `json:"type"`
+	EventID  string      `json:"event_id"`
+	SeatID   string      `json:"seat_id,omitempty"`
+	InviteID string      `json:"invite_id,omitempty"`
+	TableID  string      `json:"table_id,omitempty"`
+	TableIDs []string    `json:"table_ids,omitempty"`
+	Data     interface{} `json:"data,omitempty"`
+}
+
+// SeatingNotifier pushes seating changes to live viewers, e.g. over WebSocket. Notifications are best
+// effort and must not block the caller.
+type SeatingNotifier interface {
+	NotifySeating(ctx context.Context, e SeatingEvent)
+}
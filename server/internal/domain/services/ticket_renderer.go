@@ -0,0 +1,28 @@
+package services
+
+// TicketDocument is what a rendered ticket shows. The QR code encodes Token.
+type TicketDocument struct {
+	Token     string
+	TicketID  string
+	GuestName string
+	EventName string
+	EventDate string
+	StartTime string
+	EndTime   string
+	Location  string
+	BannerURL string
+	Seats     []TicketDocumentSeat
+}
+
+// TicketDocumentSeat is one party member's place; Table and Seat are empty while they have none.
+type TicketDocumentSeat struct {
+	Name  string
+	Table string
+	Seat  string
+}
+
+// TicketRenderer draws tickets for download and for email attachments.
+type TicketRenderer interface {
+	RenderPNG(doc TicketDocument) ([]byte, error)
+	RenderPDF(doc TicketDocument) ([]byte, error)
+}
@@ -0,0 +1,32 @@
+package services
+
+import "time"
+
+// TicketClaims is what a signed ticket vouches for: the guest behind the invite may bring PartySize
+// people to the event until ExpiresAt. PartySize is the confirmed head count when the ticket was issued,
+// the guest plus the companions they named, not the most the invite allows.
+type TicketClaims struct {
+	EventID   string
+	InviteID  string
+	PartySize int
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// TicketPublicKey is the key scanners use to verify tickets offline.
+type TicketPublicKey struct {
+	KeyID     string
+	Algorithm string // JWS algorithm name, e.g. "EdDSA"
+	PublicKey []byte // raw public key bytes
+	PEM       string // PKIX public key in PEM form
+}
+
+// TicketSigner issues and verifies tamper-proof ticket tokens. Its key is separate from the one that
+// signs session tokens.
+type TicketSigner interface {
+	Sign(claims TicketClaims) (string, error)
+	// Verify checks the token's signature and expiry and returns its claims. It fails with
+	// errors.ErrTicketExpired or errors.ErrInvalidTicket.
+	Verify(token string) (*TicketClaims, error)
+	PublicKey() TicketPublicKey
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/usecases"
+	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/http/middleware"
+	"github.com/gorilla/mux"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+var unsafeFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+type CalendarHandler struct {
+	calendarUseCase *usecases.CalendarUseCase
+}
+
+func NewCalendarHandler(calendarUseCase *usecases.CalendarUseCase) *CalendarHandler {
+	return &CalendarHandler{calendarUseCase: calendarUseCase}
+}
+
+// GetEventCalendar downloads one event as an .ics file (optional auth, same access as GET /events/{id}).
+func (h *CalendarHandler) GetEventCalendar(w http.ResponseWriter, r *http.Request) {
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	callerID, _ := middleware.GetUserID(r.Context())
+	event, body, err := h.calendarUseCase.EventCalendar(r.Context(), eventID, callerID)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "forbidden") {
+			respondWithError(w, http.StatusForbidden, err.Error())
+			return
+		}
+		respondWithError(w, http.StatusNotFound, "event not found")
+		return
+	}
+	filename := strings.Trim(unsafeFilenameChars.ReplaceAllString(strings.ToLower(event.Name), "-"), "-")
+	if filename == "" {
+		filename = "event"
+	}
+	w.Header().Set("Content-Type", calendarContentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.ics"`)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+// GetFeed returns the caller's calendar subscription URL.
+func (h *CalendarHandler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok || userID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	resp, err := h.calendarUseCase.GetFeed(r.Context(), userID)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// ResetFeed issues a new subscription URL and revokes the old one.
+func (h *CalendarHandler) ResetFeed(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok || userID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	resp, err := h.calendarUseCase.ResetFeed(r.Context(), userID)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// Feed serves a user's subscription calendar. The token in the path authenticates the request.
+func (h *CalendarHandler) Feed(w http.ResponseWriter, r *http.Request) {
+	body, err := h.calendarUseCase.Feed(r.Context(), mux.Vars(r)["token"])
+	if err != nil {
+		if err.Error() == "calendar feed not found" {
+			respondWithError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", calendarContentType)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
@@ -72,11 +72,12 @@ func (h *ChatWSHandler) Upgrade(w http.ResponseWriter, r *http.Request) {
 	h.hub.Register(client)
 	defer h.hub.Unregister(client)
 
-	go h.writePump(conn, client)
+	go writePump(conn, client)
 	h.readPump(r.Context(), conn, client, threadID, userID)
 }
 
-func (h *ChatWSHandler) writePump(conn *websocket.Conn, client *ws.Client) {
+// writePump forwards the client's messages to the connection and keeps it alive with pings.
+func writePump(conn *websocket.Conn, client *ws.Client) {
 	ticker := time.NewTicker(54 * time.Second)
 	defer ticker.Stop()
 	for {
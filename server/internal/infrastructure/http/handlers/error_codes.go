@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+// codedError maps a domain error to an HTTP status and a stable machine-readable code.
+type codedError struct {
+	err    error
+	status int
+	code   string
+}
+
+var codedErrors = []codedError{
+	{apperrors.ErrSeatTaken, http.StatusConflict, "seat_taken"},
+	{apperrors.ErrSeatHeld, http.StatusConflict, "seat_held"},
+	{apperrors.ErrSeatBlocked, http.StatusConflict, "seat_blocked"},
+	{apperrors.ErrSeatReserved, http.StatusConflict, "seat_reserved"},
+	{apperrors.ErrSeatsAssigned, http.StatusConflict, "seats_assigned"},
+	{apperrors.ErrConstraintExists, http.StatusConflict, "constraint_exists"},
+	{apperrors.ErrTableOverlap, http.StatusConflict, "table_overlap"},
+	{apperrors.ErrTableOutOfBounds, http.StatusBadRequest, "table_out_of_bounds"},
+	{apperrors.ErrEventFull, http.StatusConflict, "event_full"},
+	{apperrors.ErrInvalidSeatSelectionWindow, http.StatusBadRequest, "invalid_seat_selection_window"},
+	{apperrors.ErrRSVPClosed, http.StatusForbidden, "rsvp_closed"},
+	{apperrors.ErrSeatSelectionNotOpen, http.StatusForbidden, "seat_selection_not_open"},
+	{apperrors.ErrSeatSelectionClosed, http.StatusForbidden, "seat_selection_closed"},
+	{apperrors.ErrSeatingLocked, http.StatusConflict, "seating_locked"},
+	{apperrors.ErrPartyTooLarge, http.StatusBadRequest, "party_too_large"},
+	{apperrors.ErrInvalidRSVPAnswer, http.StatusBadRequest, "invalid_rsvp_answer"},
+	{apperrors.ErrRSVPAnswerRequired, http.StatusBadRequest, "rsvp_answer_required"},
+	{apperrors.ErrInvalidTicket, http.StatusNotFound, "invalid_ticket"},
+	{apperrors.ErrTicketExpired, http.StatusGone, "ticket_expired"},
+	{apperrors.ErrAlreadyCheckedIn, http.StatusConflict, "already_checked_in"},
+	{apperrors.ErrNotCheckedIn, http.StatusConflict, "not_checked_in"},
+}
+
+// detailedError is implemented by use case errors that carry extra data for the client.
+type detailedError interface {
+	ErrorDetails() interface{}
+}
+
+// respondWithUseCaseError writes err with its status and "code" when it wraps a known domain error,
+// and falls back to fallbackStatus with a plain error message otherwise.
+func respondWithUseCaseError(w http.ResponseWriter, fallbackStatus int, err error) {
+	for _, c := range codedErrors {
+		if errors.Is(err, c.err) {
+			body := map[string]interface{}{"error": err.Error(), "code": c.code}
+			var d detailedError
+			if errors.As(err, &d) {
+				body["details"] = d.ErrorDetails()
+			}
+			respondWithJSON(w, c.status, body)
+			return
+		}
+	}
+	respondWithError(w, fallbackStatus, err.Error())
+}
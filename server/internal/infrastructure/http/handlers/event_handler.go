@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -35,7 +36,7 @@ func (h *EventHandler) CreateEvent(w http.ResponseWriter, r *http.Request) {
 
 	resp, err := h.eventUseCase.CreateEvent(r.Context(), ownerID, req)
 	if err != nil {
-		respondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
 		return
 	}
 	respondWithJSON(w, http.StatusCreated, resp)
@@ -62,6 +63,61 @@ func (h *EventHandler) UpdateEvent(w http.ResponseWriter, r *http.Request) {
 	req.ID = id
 
 	resp, err := h.eventUseCase.UpdateEvent(r.Context(), ownerID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusForbidden, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) DuplicateEvent(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	var req dto.DuplicateEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+
+	resp, err := h.eventUseCase.DuplicateEvent(r.Context(), ownerID, id, req)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, resp)
+}
+
+// SetSeatingLocked freezes or unfreezes guest seat changes. Body: {"locked": true}.
+func (h *EventHandler) SetSeatingLocked(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+
+	var req dto.SeatingLockRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+
+	resp, err := h.eventUseCase.SetSeatingLocked(r.Context(), ownerID, id, req.Locked)
 	if err != nil {
 		respondWithError(w, http.StatusForbidden, err.Error())
 		return
@@ -123,18 +179,69 @@ func (h *EventHandler) GetTicket(w http.ResponseWriter, r *http.Request) {
 	}
 	resp, err := h.eventUseCase.GetTicketData(r.Context(), eventID, userID)
 	if err != nil {
-		if err.Error() == "event not found" || err.Error() == "you are not invited to this event" {
-			respondWithError(w, http.StatusNotFound, err.Error())
-			return
-		}
-		if err.Error() == "ticket is only available after you confirm your RSVP" {
-			respondWithError(w, http.StatusForbidden, err.Error())
+		respondWithTicketError(w, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// GetTicketPNG serves the caller's ticket as a PNG image.
+func (h *EventHandler) GetTicketPNG(w http.ResponseWriter, r *http.Request) {
+	h.renderTicket(w, r, "png", "image/png")
+}
+
+// GetTicketPDF serves the caller's ticket as a PDF document.
+func (h *EventHandler) GetTicketPDF(w http.ResponseWriter, r *http.Request) {
+	h.renderTicket(w, r, "pdf", "application/pdf")
+}
+
+// renderTicket serves the caller's ticket, or with ?invite_id= a guest's ticket to the organizer.
+func (h *EventHandler) renderTicket(w http.ResponseWriter, r *http.Request, format, contentType string) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok || userID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	var ticket *dto.TicketResponse
+	if inviteID := r.URL.Query().Get("invite_id"); inviteID != "" {
+		if _, err := uuid.Parse(inviteID); err != nil {
+			respondWithError(w, http.StatusBadRequest, "invalid invite id")
 			return
 		}
-		respondWithError(w, http.StatusBadRequest, err.Error())
+		ticket, err = h.eventUseCase.GetInviteTicketData(r.Context(), userID, eventID, inviteID)
+	} else {
+		ticket, err = h.eventUseCase.GetTicketData(r.Context(), eventID, userID)
+	}
+	if err != nil {
+		respondWithTicketError(w, err)
 		return
 	}
-	respondWithJSON(w, http.StatusOK, resp)
+	body, err := h.eventUseCase.RenderTicket(ticket, format)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, "failed to render ticket")
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="ticket-%s.%s"`, ticket.TicketID, format))
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func respondWithTicketError(w http.ResponseWriter, err error) {
+	switch err.Error() {
+	case "event not found", "you are not invited to this event":
+		respondWithError(w, http.StatusNotFound, err.Error())
+	case "ticket is only available after you confirm your RSVP", "you are not the owner of this event":
+		respondWithError(w, http.StatusForbidden, err.Error())
+	default:
+		respondWithError(w, http.StatusBadRequest, err.Error())
+	}
 }
 
 func (h *EventHandler) GetEvents(w http.ResponseWriter, r *http.Request) {
@@ -217,7 +324,7 @@ func (h *EventHandler) InviteUserToEvent(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	resp, err := h.eventUseCase.InviteUserToEvent(r.Context(), ownerID, eventID, req.Email)
+	resp, err := h.eventUseCase.InviteUserToEvent(r.Context(), ownerID, eventID, req.Email, req.PartySize)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
@@ -225,6 +332,36 @@ func (h *EventHandler) InviteUserToEvent(w http.ResponseWriter, r *http.Request)
 	respondWithJSON(w, http.StatusCreated, resp)
 }
 
+// UpdateInvite changes an invite's party size. Body: {"party_size": 4}.
+func (h *EventHandler) UpdateInvite(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	inviteID, err := parseInviteIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid invite id")
+		return
+	}
+	var req dto.UpdateInviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.UpdateInvitePartySize(r.Context(), ownerID, eventID, inviteID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
 func (h *EventHandler) ListEventInvites(w http.ResponseWriter, r *http.Request) {
 	ownerID, ok := middleware.GetUserID(r.Context())
 	if !ok || ownerID == "" {
@@ -282,43 +419,57 @@ func (h *EventHandler) RespondToInvite(w http.ResponseWriter, r *http.Request) {
 		respondWithError(w, http.StatusBadRequest, "invalid request payload")
 		return
 	}
-	resp, err := h.eventUseCase.RespondToInvite(r.Context(), userID, eventID, req.Status, req.SeatID, req.GuestSeatID)
+	resp, err := h.eventUseCase.RespondToInvite(r.Context(), userID, eventID, req)
 	if err != nil {
-		respondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
 		return
 	}
 	respondWithJSON(w, http.StatusOK, resp)
 }
 
-func parseIDFromPath(r *http.Request) (string, error) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
-	if idStr == "" {
-		return "", strconv.ErrSyntax
+func (h *EventHandler) HoldSeats(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok || userID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
 	}
-	if _, err := uuid.Parse(idStr); err != nil {
-		return "", err
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
 	}
-	return idStr, nil
+	var req dto.SeatHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.HoldSeats(r.Context(), userID, eventID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, resp)
 }
 
-func parseLimitOffset(r *http.Request) (limit, offset int) {
-	limit = 20
-	offset = 0
-	if l := r.URL.Query().Get("limit"); l != "" {
-		if n, err := strconv.Atoi(l); err == nil && n > 0 && n <= 100 {
-			limit = n
-		}
+func (h *EventHandler) ReleaseSeatHolds(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok || userID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
 	}
-	if o := r.URL.Query().Get("offset"); o != "" {
-		if n, err := strconv.Atoi(o); err == nil && n >= 0 {
-			offset = n
-		}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
 	}
-	return limit, offset
+	if err := h.eventUseCase.ReleaseSeatHolds(r.Context(), userID, eventID); err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *EventHandler) CreateEventTable(w http.ResponseWriter, r *http.Request) {
+func (h *EventHandler) PreviewAutoSeating(w http.ResponseWriter, r *http.Request) {
 	ownerID, ok := middleware.GetUserID(r.Context())
 	if !ok || ownerID == "" {
 		respondWithError(w, http.StatusUnauthorized, "unauthorized")
@@ -329,20 +480,22 @@ func (h *EventHandler) CreateEventTable(w http.ResponseWriter, r *http.Request)
 		respondWithError(w, http.StatusBadRequest, "invalid event id")
 		return
 	}
-	var req dto.CreateEventTableRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		respondWithError(w, http.StatusBadRequest, "invalid request payload")
-		return
+	var req dto.AutoSeatRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondWithError(w, http.StatusBadRequest, "invalid request payload")
+			return
+		}
 	}
-	resp, err := h.eventUseCase.CreateEventTable(r.Context(), ownerID, eventID, req)
+	resp, err := h.eventUseCase.PreviewAutoSeating(r.Context(), ownerID, eventID, req)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	respondWithJSON(w, http.StatusCreated, resp)
+	respondWithJSON(w, http.StatusOK, resp)
 }
 
-func (h *EventHandler) ReorderEventTables(w http.ResponseWriter, r *http.Request) {
+func (h *EventHandler) ApplySeatingPlan(w http.ResponseWriter, r *http.Request) {
 	ownerID, ok := middleware.GetUserID(r.Context())
 	if !ok || ownerID == "" {
 		respondWithError(w, http.StatusUnauthorized, "unauthorized")
@@ -353,37 +506,44 @@ func (h *EventHandler) ReorderEventTables(w http.ResponseWriter, r *http.Request
 		respondWithError(w, http.StatusBadRequest, "invalid event id")
 		return
 	}
-	var req dto.ReorderEventTablesRequest
+	var req dto.ApplySeatingRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondWithError(w, http.StatusBadRequest, "invalid request payload")
 		return
 	}
-	if err := h.eventUseCase.ReorderEventTables(r.Context(), ownerID, eventID, req.TableIDs); err != nil {
-		respondWithError(w, http.StatusBadRequest, err.Error())
+	resp, err := h.eventUseCase.ApplySeatingPlan(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
 		return
 	}
-	respondWithJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	respondWithJSON(w, http.StatusOK, resp)
 }
 
-func (h *EventHandler) ListEventSeating(w http.ResponseWriter, r *http.Request) {
+func (h *EventHandler) AssignSeat(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
 	eventID, err := parseIDFromPath(r)
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "invalid event id")
 		return
 	}
-	callerID, _ := middleware.GetUserID(r.Context())
-	tables, err := h.eventUseCase.ListEventSeating(r.Context(), eventID, callerID)
-	if err != nil {
-		respondWithError(w, http.StatusForbidden, err.Error())
+	var req dto.AssignSeatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
 		return
 	}
-	if tables == nil {
-		tables = []*dto.EventTableResponse{}
+	resp, err := h.eventUseCase.AssignSeat(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
 	}
-	respondWithJSON(w, http.StatusOK, tables)
+	respondWithJSON(w, http.StatusOK, resp)
 }
 
-func (h *EventHandler) UpdateEventTable(w http.ResponseWriter, r *http.Request) {
+func (h *EventHandler) UnassignSeat(w http.ResponseWriter, r *http.Request) {
 	ownerID, ok := middleware.GetUserID(r.Context())
 	if !ok || ownerID == "" {
 		respondWithError(w, http.StatusUnauthorized, "unauthorized")
@@ -394,25 +554,44 @@ func (h *EventHandler) UpdateEventTable(w http.ResponseWriter, r *http.Request)
 		respondWithError(w, http.StatusBadRequest, "invalid event id")
 		return
 	}
-	tableID, err := parseTableIDFromPath(r)
+	var req dto.UnassignSeatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.UnassignSeat(r.Context(), ownerID, eventID, req)
 	if err != nil {
-		respondWithError(w, http.StatusBadRequest, "invalid table id")
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
 		return
 	}
-	var req dto.UpdateEventTableRequest
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) MoveSeat(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	var req dto.MoveSeatRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		respondWithError(w, http.StatusBadRequest, "invalid request payload")
 		return
 	}
-	resp, err := h.eventUseCase.UpdateEventTable(r.Context(), ownerID, eventID, tableID, req)
+	resp, err := h.eventUseCase.MoveSeat(r.Context(), ownerID, eventID, req)
 	if err != nil {
-		respondWithError(w, http.StatusBadRequest, err.Error())
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
 		return
 	}
 	respondWithJSON(w, http.StatusOK, resp)
 }
 
-func (h *EventHandler) DeleteEventTable(w http.ResponseWriter, r *http.Request) {
+func (h *EventHandler) SwapSeats(w http.ResponseWriter, r *http.Request) {
 	ownerID, ok := middleware.GetUserID(r.Context())
 	if !ok || ownerID == "" {
 		respondWithError(w, http.StatusUnauthorized, "unauthorized")
@@ -423,21 +602,22 @@ func (h *EventHandler) DeleteEventTable(w http.ResponseWriter, r *http.Request)
 		respondWithError(w, http.StatusBadRequest, "invalid event id")
 		return
 	}
-	tableID, err := parseTableIDFromPath(r)
-	if err != nil {
-		respondWithError(w, http.StatusBadRequest, "invalid table id")
+	var req dto.SwapSeatsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
 		return
 	}
-	if err := h.eventUseCase.DeleteEventTable(r.Context(), ownerID, eventID, tableID); err != nil {
-		respondWithError(w, http.StatusBadRequest, err.Error())
+	resp, err := h.eventUseCase.SwapSeats(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	respondWithJSON(w, http.StatusOK, resp)
 }
 
-func parseTableIDFromPath(r *http.Request) (string, error) {
+func parseIDFromPath(r *http.Request) (string, error) {
 	vars := mux.Vars(r)
-	idStr := vars["tableId"]
+	idStr := vars["id"]
 	if idStr == "" {
 		return "", strconv.ErrSyntax
 	}
@@ -446,3 +626,747 @@ func parseTableIDFromPath(r *http.Request) (string, error) {
 	}
 	return idStr, nil
 }
+
+func parseLimitOffset(r *http.Request) (limit, offset int) {
+	limit = 20
+	offset = 0
+	if l := r.URL.Query().Get("limit"); l != "" {
+		if n, err := strconv.Atoi(l); err == nil && n > 0 && n <= 100 {
+			limit = n
+		}
+	}
+	if o := r.URL.Query().Get("offset"); o != "" {
+		if n, err := strconv.Atoi(o); err == nil && n >= 0 {
+			offset = n
+		}
+	}
+	return limit, offset
+}
+
+func (h *EventHandler) CreateEventTable(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	roomID, err := parseOptionalRoomIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+	var req dto.CreateEventTableRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.CreateEventTable(r.Context(), ownerID, eventID, roomID, req)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, resp)
+}
+
+func (h *EventHandler) ReorderEventTables(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	roomID, err := parseOptionalRoomIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+	var req dto.ReorderEventTablesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	if err := h.eventUseCase.ReorderEventTables(r.Context(), ownerID, eventID, roomID, req.TableIDs); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (h *EventHandler) ListEventSeating(w http.ResponseWriter, r *http.Request) {
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	callerID, _ := middleware.GetUserID(r.Context())
+	seating, err := h.eventUseCase.ListEventSeating(r.Context(), eventID, callerID)
+	if err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, seating)
+}
+
+func (h *EventHandler) UpdateEventTable(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	tableID, err := parseTableIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid table id")
+		return
+	}
+	roomID, err := parseOptionalRoomIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+	var req dto.UpdateEventTableRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.UpdateEventTable(r.Context(), ownerID, eventID, roomID, tableID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) DeleteEventTable(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	tableID, err := parseTableIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid table id")
+		return
+	}
+	roomID, err := parseOptionalRoomIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+	if err := h.eventUseCase.DeleteEventTable(r.Context(), ownerID, eventID, roomID, tableID); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *EventHandler) UpdateEventSeat(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	tableID, err := parseTableIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid table id")
+		return
+	}
+	seatID, err := parseSeatIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid seat id")
+		return
+	}
+	var req dto.UpdateEventSeatRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.UpdateEventSeat(r.Context(), ownerID, eventID, tableID, seatID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) CreateFloorElement(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	var req dto.FloorElementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.CreateFloorElement(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, resp)
+}
+
+func (h *EventHandler) UpdateFloorElement(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	elementID, err := parseElementIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid element id")
+		return
+	}
+	var req dto.FloorElementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.UpdateFloorElement(r.Context(), ownerID, eventID, elementID, req)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) DeleteFloorElement(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	elementID, err := parseElementIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid element id")
+		return
+	}
+	if err := h.eventUseCase.DeleteFloorElement(r.Context(), ownerID, eventID, elementID); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *EventHandler) ListEventRooms(w http.ResponseWriter, r *http.Request) {
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	callerID, _ := middleware.GetUserID(r.Context())
+	rooms, err := h.eventUseCase.ListEventRooms(r.Context(), eventID, callerID)
+	if err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, rooms)
+}
+
+func (h *EventHandler) ListRoomSeating(w http.ResponseWriter, r *http.Request) {
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	roomID, err := parseRoomIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+	callerID, _ := middleware.GetUserID(r.Context())
+	seating, err := h.eventUseCase.ListRoomSeating(r.Context(), eventID, roomID, callerID)
+	if err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, seating)
+}
+
+func (h *EventHandler) CreateEventRoom(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	var req dto.EventRoomRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.CreateEventRoom(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, resp)
+}
+
+func (h *EventHandler) UpdateEventRoom(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	roomID, err := parseRoomIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+	var req dto.EventRoomRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.UpdateEventRoom(r.Context(), ownerID, eventID, roomID, req)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) DeleteEventRoom(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	roomID, err := parseRoomIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid room id")
+		return
+	}
+	if err := h.eventUseCase.DeleteEventRoom(r.Context(), ownerID, eventID, roomID); err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *EventHandler) ListSeatingSnapshots(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	limit, offset := parseLimitOffset(r)
+	resp, err := h.eventUseCase.ListSeatingSnapshots(r.Context(), ownerID, eventID, limit, offset)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// DiffSeatingSnapshots compares the snapshots given by the from and to query parameters.
+func (h *EventHandler) DiffSeatingSnapshots(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	fromID := r.URL.Query().Get("from")
+	toID := r.URL.Query().Get("to")
+	if _, err := uuid.Parse(fromID); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid from snapshot id")
+		return
+	}
+	if _, err := uuid.Parse(toID); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid to snapshot id")
+		return
+	}
+	resp, err := h.eventUseCase.DiffSeatingSnapshots(r.Context(), ownerID, eventID, fromID, toID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) RestoreSeatingSnapshot(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	snapshotID, err := parseSnapshotIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid snapshot id")
+		return
+	}
+	resp, err := h.eventUseCase.RestoreSeatingSnapshot(r.Context(), ownerID, eventID, snapshotID)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func parseSnapshotIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["snapshotId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
+
+// ListRSVPQuestions returns the event's RSVP form with the caller's saved answers (optional auth).
+func (h *EventHandler) ListRSVPQuestions(w http.ResponseWriter, r *http.Request) {
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	callerID, _ := middleware.GetUserID(r.Context())
+	resp, err := h.eventUseCase.ListRSVPQuestions(r.Context(), eventID, callerID)
+	if err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) CreateRSVPQuestion(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	var req dto.RSVPQuestionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.CreateRSVPQuestion(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, resp)
+}
+
+func (h *EventHandler) UpdateRSVPQuestion(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	questionID, err := parseQuestionIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid question id")
+		return
+	}
+	var req dto.RSVPQuestionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.UpdateRSVPQuestion(r.Context(), ownerID, eventID, questionID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *EventHandler) DeleteRSVPQuestion(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	questionID, err := parseQuestionIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid question id")
+		return
+	}
+	if err := h.eventUseCase.DeleteRSVPQuestion(r.Context(), ownerID, eventID, questionID); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetRSVPSummary returns the organizer's tally of RSVP answers.
+func (h *EventHandler) GetRSVPSummary(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	resp, err := h.eventUseCase.GetRSVPSummary(r.Context(), ownerID, eventID)
+	if err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func parseQuestionIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["questionId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
+
+func parseInviteIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["inviteId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
+
+func parseRoomIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["roomId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
+
+// parseOptionalRoomIDFromPath returns "" for the event-wide routes that have no {roomId} segment.
+func parseOptionalRoomIDFromPath(r *http.Request) (string, error) {
+	if mux.Vars(r)["roomId"] == "" {
+		return "", nil
+	}
+	return parseRoomIDFromPath(r)
+}
+
+func parseTableIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["tableId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
+
+func parseSeatIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["seatId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
+
+func parseElementIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["elementId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
+
+// CheckIn marks the party behind a scanned or typed ticket as arrived.
+func (h *EventHandler) CheckIn(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	var req dto.CheckInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.CheckInGuest(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// UndoCheckIn removes the check-in of a party, or of one companion with ?companion_id=.
+func (h *EventHandler) UndoCheckIn(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	inviteID, err := parseInviteIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid invite id")
+		return
+	}
+	resp, err := h.eventUseCase.UndoCheckIn(r.Context(), ownerID, eventID, inviteID, r.URL.Query().Get("companion_id"))
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// ListCheckIns returns the door list with arrival and no-show counts.
+func (h *EventHandler) ListCheckIns(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	resp, err := h.eventUseCase.ListCheckIns(r.Context(), ownerID, eventID)
+	if err != nil {
+		respondWithError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// VerifyTicket checks a signed ticket token for the organizer of its event.
+func (h *EventHandler) VerifyTicket(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	var req dto.VerifyTicketRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.eventUseCase.VerifyTicket(r.Context(), ownerID, req.Token)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusForbidden, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+// TicketPublicKey exports the key scanners use to verify tickets offline.
+func (h *EventHandler) TicketPublicKey(w http.ResponseWriter, r *http.Request) {
+	respondWithJSON(w, http.StatusOK, h.eventUseCase.TicketPublicKey())
+}
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/usecases"
+	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/http/middleware"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type SeatingConstraintHandler struct {
+	constraintUseCase *usecases.SeatingConstraintUseCase
+}
+
+func NewSeatingConstraintHandler(constraintUseCase *usecases.SeatingConstraintUseCase) *SeatingConstraintHandler {
+	return &SeatingConstraintHandler{constraintUseCase: constraintUseCase}
+}
+
+func (h *SeatingConstraintHandler) ListConstraints(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	list, err := h.constraintUseCase.ListConstraints(r.Context(), ownerID, eventID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, list)
+}
+
+func (h *SeatingConstraintHandler) CreateConstraint(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	var req dto.SeatingConstraintRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.constraintUseCase.CreateConstraint(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, resp)
+}
+
+func (h *SeatingConstraintHandler) UpdateConstraint(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	constraintID, err := parseConstraintIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid rule id")
+		return
+	}
+	var req dto.SeatingConstraintRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.constraintUseCase.UpdateConstraint(r.Context(), ownerID, eventID, constraintID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *SeatingConstraintHandler) DeleteConstraint(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	constraintID, err := parseConstraintIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid rule id")
+		return
+	}
+	if err := h.constraintUseCase.DeleteConstraint(r.Context(), ownerID, eventID, constraintID); err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SeatingConstraintHandler) ValidateSeating(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	report, err := h.constraintUseCase.ValidateSeating(r.Context(), ownerID, eventID)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, report)
+}
+
+func parseConstraintIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["constraintId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/usecases"
+	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/http/ws"
+	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/security"
+	"github.com/gorilla/websocket"
+)
+
+// SeatingWSHandler streams live seating chart changes of an event over WebSocket.
+type SeatingWSHandler struct {
+	eventUseCase *usecases.EventUseCase
+	jwt          *security.JWTManager
+	hub          *ws.SeatingHub
+}
+
+func NewSeatingWSHandler(eventUseCase *usecases.EventUseCase, jwt *security.JWTManager, hub *ws.SeatingHub) *SeatingWSHandler {
+	return &SeatingWSHandler{eventUseCase: eventUseCase, jwt: jwt, hub: hub}
+}
+
+// Upgrade upgrades HTTP to WebSocket. URL: /api/v1/ws/events/:id/seating?token=xxx
+// The token is optional, as for GET /events/:id/seating: anonymous viewers may watch public events.
+func (h *SeatingWSHandler) Upgrade(w http.ResponseWriter, r *http.Request) {
+	tokenStr := r.URL.Query().Get("token")
+	if tokenStr == "" {
+		if ah := r.Header.Get("Authorization"); len(ah) > 7 && strings.EqualFold(ah[:7], "Bearer ") {
+			tokenStr = ah[7:]
+		}
+	}
+	userID := ""
+	if tokenStr != "" {
+		claims, err := h.jwt.ValidateToken(tokenStr)
+		if err != nil {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		userID = claims.UserID
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		http.Error(w, "invalid event id", http.StatusBadRequest)
+		return
+	}
+	if err := h.eventUseCase.CanViewSeating(r.Context(), eventID, userID); err != nil {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	conn, err := wsUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	client := &ws.Client{
+		UserID:  userID,
+		EventID: eventID,
+		Send:    make(chan []byte, 256),
+	}
+	h.hub.Register(client)
+	defer h.hub.Unregister(client)
+
+	go writePump(conn, client)
+	h.readPump(conn)
+}
+
+// readPump only watches for the connection to close; viewers change seating through the REST API.
+func (h *SeatingWSHandler) readPump(conn *websocket.Conn) {
+	defer func() { _ = conn.Close() }()
+	conn.SetReadLimit(4 * 1024)
+	conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+	conn.SetPongHandler(func(string) error {
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		return nil
+	})
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+	}
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/dto"
+	"github.com/KalebAsratemedhin/seatmaster/internal/application/usecases"
+	"github.com/KalebAsratemedhin/seatmaster/internal/infrastructure/http/middleware"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+)
+
+type VenueLayoutHandler struct {
+	layoutUseCase *usecases.VenueLayoutUseCase
+}
+
+func NewVenueLayoutHandler(layoutUseCase *usecases.VenueLayoutUseCase) *VenueLayoutHandler {
+	return &VenueLayoutHandler{layoutUseCase: layoutUseCase}
+}
+
+func (h *VenueLayoutHandler) ListLayouts(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	list, err := h.layoutUseCase.ListLayouts(r.Context(), ownerID)
+	if err != nil {
+		respondWithError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, list)
+}
+
+func (h *VenueLayoutHandler) GetLayout(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	layoutID, err := parseLayoutIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid layout id")
+		return
+	}
+	resp, err := h.layoutUseCase.GetLayout(r.Context(), ownerID, layoutID)
+	if err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *VenueLayoutHandler) SaveEventLayout(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	var req dto.SaveLayoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.layoutUseCase.SaveEventLayout(r.Context(), ownerID, eventID, req)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusCreated, resp)
+}
+
+func (h *VenueLayoutHandler) UpdateLayout(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	layoutID, err := parseLayoutIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid layout id")
+		return
+	}
+	var req dto.UpdateLayoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid request payload")
+		return
+	}
+	resp, err := h.layoutUseCase.UpdateLayout(r.Context(), ownerID, layoutID, req)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func (h *VenueLayoutHandler) DeleteLayout(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	layoutID, err := parseLayoutIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid layout id")
+		return
+	}
+	if err := h.layoutUseCase.DeleteLayout(r.Context(), ownerID, layoutID); err != nil {
+		respondWithError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *VenueLayoutHandler) ApplyLayout(w http.ResponseWriter, r *http.Request) {
+	ownerID, ok := middleware.GetUserID(r.Context())
+	if !ok || ownerID == "" {
+		respondWithError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+	eventID, err := parseIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid event id")
+		return
+	}
+	layoutID, err := parseLayoutIDFromPath(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "invalid layout id")
+		return
+	}
+	var req dto.ApplyLayoutRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			respondWithError(w, http.StatusBadRequest, "invalid request payload")
+			return
+		}
+	}
+	resp, err := h.layoutUseCase.ApplyLayout(r.Context(), ownerID, eventID, layoutID, req)
+	if err != nil {
+		respondWithUseCaseError(w, http.StatusBadRequest, err)
+		return
+	}
+	respondWithJSON(w, http.StatusOK, resp)
+}
+
+func parseLayoutIDFromPath(r *http.Request) (string, error) {
+	vars := mux.Vars(r)
+	idStr := vars["layoutId"]
+	if idStr == "" {
+		return "", strconv.ErrSyntax
+	}
+	if _, err := uuid.Parse(idStr); err != nil {
+		return "", err
+	}
+	return idStr, nil
+}
@@ -17,7 +17,11 @@ type Router struct {
 	commentHandler  *handlers.CommentHandler
 	chatHandler      *handlers.ChatHandler
 	chatWSHandler    *handlers.ChatWSHandler
+	seatingWSHandler *handlers.SeatingWSHandler
 	dashboardHandler *handlers.DashboardHandler
+	constraintHandler *handlers.SeatingConstraintHandler
+	layoutHandler    *handlers.VenueLayoutHandler
+	calendarHandler  *handlers.CalendarHandler
 	authMiddleware   *middleware.AuthMiddleware
 }
 
@@ -29,7 +33,11 @@ func NewRouter(
 	commentHandler *handlers.CommentHandler,
 	chatHandler *handlers.ChatHandler,
 	chatWSHandler *handlers.ChatWSHandler,
+	seatingWSHandler *handlers.SeatingWSHandler,
 	dashboardHandler *handlers.DashboardHandler,
+	constraintHandler *handlers.SeatingConstraintHandler,
+	layoutHandler *handlers.VenueLayoutHandler,
+	calendarHandler *handlers.CalendarHandler,
 	authMiddleware *middleware.AuthMiddleware,
 ) *Router {
 	return &Router{
@@ -40,7 +48,11 @@ func NewRouter(
 		commentHandler:   commentHandler,
 		chatHandler:      chatHandler,
 		chatWSHandler:    chatWSHandler,
+		seatingWSHandler: seatingWSHandler,
 		dashboardHandler: dashboardHandler,
+		constraintHandler: constraintHandler,
+		layoutHandler:    layoutHandler,
+		calendarHandler:  calendarHandler,
 		authMiddleware:   authMiddleware,
 	}
 }
@@ -56,12 +68,19 @@ func (r *Router) SetupRoutes(uploadDir string) *mux.Router {
 	api := router.PathPrefix("/api/v1").Subrouter()
 	api.HandleFunc("/auth/register", r.authHandler.Register).Methods("POST")
 	api.HandleFunc("/auth/login", r.authHandler.Login).Methods("POST")
+	api.HandleFunc("/tickets/public-key", r.eventHandler.TicketPublicKey).Methods("GET")
+	// Calendar subscription feed: the token in the URL authenticates calendar apps
+	api.HandleFunc("/calendar/{token}.ics", r.calendarHandler.Feed).Methods("GET")
 
 	// Event routes that work with or without auth (optional auth so owner/invited can see private events)
 	eventsPublic := api.PathPrefix("/events").Subrouter()
 	eventsPublic.Use(r.authMiddleware.OptionalAuth)
 	eventsPublic.HandleFunc("/public", r.eventHandler.ListPublicEvents).Methods("GET")
 	eventsPublic.HandleFunc("/{id}/seating", r.eventHandler.ListEventSeating).Methods("GET")
+	eventsPublic.HandleFunc("/{id}/rooms", r.eventHandler.ListEventRooms).Methods("GET")
+	eventsPublic.HandleFunc("/{id}/rooms/{roomId}/seating", r.eventHandler.ListRoomSeating).Methods("GET")
+	eventsPublic.HandleFunc("/{id}/questions", r.eventHandler.ListRSVPQuestions).Methods("GET")
+	eventsPublic.HandleFunc("/{id}/calendar.ics", r.calendarHandler.GetEventCalendar).Methods("GET")
 	eventsPublic.HandleFunc("/{id}", r.eventHandler.GetEvent).Methods("GET")
 	// Public comments (optional auth: need access to event)
 	eventsPublic.HandleFunc("/{id}/comments", r.commentHandler.ListComments).Methods("GET")
@@ -78,24 +97,74 @@ func (r *Router) SetupRoutes(uploadDir string) *mux.Router {
 	protected.HandleFunc("/upload/avatar", r.uploadHandler.UploadAvatar).Methods("POST")
 	protected.HandleFunc("/users/me", r.profileHandler.GetProfile).Methods("GET")
 	protected.HandleFunc("/users/me", r.profileHandler.UpdateProfile).Methods("PUT")
+	protected.HandleFunc("/users/me/calendar", r.calendarHandler.GetFeed).Methods("GET")
+	protected.HandleFunc("/users/me/calendar/reset", r.calendarHandler.ResetFeed).Methods("POST")
 	protected.HandleFunc("/dashboard", r.dashboardHandler.GetDashboard).Methods("GET")
 	protected.HandleFunc("/events", r.eventHandler.CreateEvent).Methods("POST")
 	protected.HandleFunc("/events", r.eventHandler.GetEvents).Methods("GET")
 	protected.HandleFunc("/events/{id}/ticket", r.eventHandler.GetTicket).Methods("GET")
+	protected.HandleFunc("/events/{id}/ticket.png", r.eventHandler.GetTicketPNG).Methods("GET")
+	protected.HandleFunc("/events/{id}/ticket.pdf", r.eventHandler.GetTicketPDF).Methods("GET")
+	protected.HandleFunc("/tickets/verify", r.eventHandler.VerifyTicket).Methods("POST")
+	protected.HandleFunc("/events/{id}/duplicate", r.eventHandler.DuplicateEvent).Methods("POST")
 	protected.HandleFunc("/events/invitations", r.eventHandler.GetInvitationEvents).Methods("GET")
 	protected.HandleFunc("/invitations", r.eventHandler.GetMyInvitations).Methods("GET")
 	protected.HandleFunc("/events/{id}/invites", r.eventHandler.ListEventInvites).Methods("GET")
 	protected.HandleFunc("/events/{id}/invites", r.eventHandler.InviteUserToEvent).Methods("POST")
+	protected.HandleFunc("/events/{id}/invites/{inviteId}", r.eventHandler.UpdateInvite).Methods("PUT")
 	protected.HandleFunc("/events/{id}/rsvp", r.eventHandler.RespondToInvite).Methods("PUT")
+	protected.HandleFunc("/events/{id}/questions", r.eventHandler.CreateRSVPQuestion).Methods("POST")
+	protected.HandleFunc("/events/{id}/questions/summary", r.eventHandler.GetRSVPSummary).Methods("GET")
+	protected.HandleFunc("/events/{id}/questions/{questionId}", r.eventHandler.UpdateRSVPQuestion).Methods("PUT")
+	protected.HandleFunc("/events/{id}/questions/{questionId}", r.eventHandler.DeleteRSVPQuestion).Methods("DELETE")
+	protected.HandleFunc("/events/{id}/check-ins", r.eventHandler.ListCheckIns).Methods("GET")
+	protected.HandleFunc("/events/{id}/check-ins", r.eventHandler.CheckIn).Methods("POST")
+	protected.HandleFunc("/events/{id}/check-ins/{inviteId}", r.eventHandler.UndoCheckIn).Methods("DELETE")
+	protected.HandleFunc("/events/{id}/seating/holds", r.eventHandler.HoldSeats).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/holds", r.eventHandler.ReleaseSeatHolds).Methods("DELETE")
 	protected.HandleFunc("/events/{id}/tables", r.eventHandler.CreateEventTable).Methods("POST")
 	protected.HandleFunc("/events/{id}/seating/order", r.eventHandler.ReorderEventTables).Methods("PUT")
+	protected.HandleFunc("/events/{id}/seating/auto", r.eventHandler.PreviewAutoSeating).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/apply", r.eventHandler.ApplySeatingPlan).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/assign", r.eventHandler.AssignSeat).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/unassign", r.eventHandler.UnassignSeat).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/move", r.eventHandler.MoveSeat).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/swap", r.eventHandler.SwapSeats).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/lock", r.eventHandler.SetSeatingLocked).Methods("PUT")
+	protected.HandleFunc("/events/{id}/seating/snapshots", r.eventHandler.ListSeatingSnapshots).Methods("GET")
+	protected.HandleFunc("/events/{id}/seating/snapshots/diff", r.eventHandler.DiffSeatingSnapshots).Methods("GET")
+	protected.HandleFunc("/events/{id}/seating/snapshots/{snapshotId}/restore", r.eventHandler.RestoreSeatingSnapshot).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/constraints", r.constraintHandler.ListConstraints).Methods("GET")
+	protected.HandleFunc("/events/{id}/seating/constraints", r.constraintHandler.CreateConstraint).Methods("POST")
+	protected.HandleFunc("/events/{id}/seating/constraints/report", r.constraintHandler.ValidateSeating).Methods("GET")
+	protected.HandleFunc("/events/{id}/seating/constraints/{constraintId}", r.constraintHandler.UpdateConstraint).Methods("PUT")
+	protected.HandleFunc("/events/{id}/seating/constraints/{constraintId}", r.constraintHandler.DeleteConstraint).Methods("DELETE")
 	protected.HandleFunc("/events/{id}/tables/{tableId}", r.eventHandler.UpdateEventTable).Methods("PUT")
 	protected.HandleFunc("/events/{id}/tables/{tableId}", r.eventHandler.DeleteEventTable).Methods("DELETE")
+	protected.HandleFunc("/events/{id}/tables/{tableId}/seats/{seatId}", r.eventHandler.UpdateEventSeat).Methods("PUT")
+	protected.HandleFunc("/events/{id}/layouts", r.layoutHandler.SaveEventLayout).Methods("POST")
+	protected.HandleFunc("/events/{id}/layouts/{layoutId}/apply", r.layoutHandler.ApplyLayout).Methods("POST")
+	protected.HandleFunc("/layouts", r.layoutHandler.ListLayouts).Methods("GET")
+	protected.HandleFunc("/layouts/{layoutId}", r.layoutHandler.GetLayout).Methods("GET")
+	protected.HandleFunc("/layouts/{layoutId}", r.layoutHandler.UpdateLayout).Methods("PUT")
+	protected.HandleFunc("/layouts/{layoutId}", r.layoutHandler.DeleteLayout).Methods("DELETE")
+	protected.HandleFunc("/events/{id}/rooms", r.eventHandler.CreateEventRoom).Methods("POST")
+	protected.HandleFunc("/events/{id}/rooms/{roomId}", r.eventHandler.UpdateEventRoom).Methods("PUT")
+	protected.HandleFunc("/events/{id}/rooms/{roomId}", r.eventHandler.DeleteEventRoom).Methods("DELETE")
+	protected.HandleFunc("/events/{id}/rooms/{roomId}/tables", r.eventHandler.CreateEventTable).Methods("POST")
+	protected.HandleFunc("/events/{id}/rooms/{roomId}/tables/order", r.eventHandler.ReorderEventTables).Methods("PUT")
+	protected.HandleFunc("/events/{id}/rooms/{roomId}/tables/{tableId}", r.eventHandler.UpdateEventTable).Methods("PUT")
+	protected.HandleFunc("/events/{id}/rooms/{roomId}/tables/{tableId}", r.eventHandler.DeleteEventTable).Methods("DELETE")
+	protected.HandleFunc("/events/{id}/floor/elements", r.eventHandler.CreateFloorElement).Methods("POST")
+	protected.HandleFunc("/events/{id}/floor/elements/{elementId}", r.eventHandler.UpdateFloorElement).Methods("PUT")
+	protected.HandleFunc("/events/{id}/floor/elements/{elementId}", r.eventHandler.DeleteFloorElement).Methods("DELETE")
 	protected.HandleFunc("/events/{id}", r.eventHandler.UpdateEvent).Methods("PUT")
 	protected.HandleFunc("/events/{id}", r.eventHandler.DeleteEvent).Methods("DELETE")
 
 	// WebSocket: chat (token in query or header)
 	api.HandleFunc("/ws/chat/threads/{threadId}", r.chatWSHandler.Upgrade).Methods("GET")
+	// WebSocket: live seating chart (token optional, same access as GET /events/{id}/seating)
+	api.HandleFunc("/ws/events/{id}/seating", r.seatingWSHandler.Upgrade).Methods("GET")
 
 	return router
 }
\ No newline at end of file
@@ -5,10 +5,11 @@ import (
 	"sync"
 )
 
-// Client is a WebSocket client in a chat thread.
+// Client is a WebSocket client in a chat thread (Hub) or an event's seating channel (SeatingHub).
 type Client struct {
-	UserID   string
+	UserID   string // empty for anonymous viewers of a public seating chart
 	ThreadID string
+	EventID  string
 	Send     chan []byte
 }
 
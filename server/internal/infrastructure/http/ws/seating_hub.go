@@ -0,0 +1,64 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+)
+
+// SeatingHub holds the clients watching each event's seating chart and implements
+// services.SeatingNotifier by broadcasting to them.
+type SeatingHub struct {
+	// eventID -> clients
+	events map[string]map[*Client]bool
+	mu     sync.RWMutex
+}
+
+func NewSeatingHub() *SeatingHub {
+	return &SeatingHub{
+		events: make(map[string]map[*Client]bool),
+	}
+}
+
+// Register adds a client to its event's seating channel.
+func (h *SeatingHub) Register(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.events[c.EventID] == nil {
+		h.events[c.EventID] = make(map[*Client]bool)
+	}
+	h.events[c.EventID][c] = true
+}
+
+// Unregister removes a client.
+func (h *SeatingHub) Unregister(c *Client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if m, ok := h.events[c.EventID]; ok {
+		delete(m, c)
+		if len(m) == 0 {
+			delete(h.events, c.EventID)
+		}
+	}
+	close(c.Send)
+}
+
+// NotifySeating broadcasts the change to every client watching the event.
+func (h *SeatingHub) NotifySeating(ctx context.Context, e services.SeatingEvent) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	// Sends never block, so the read lock is held throughout; Unregister cannot close a channel mid-send.
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for c := range h.events[e.EventID] {
+		select {
+		case c.Send <- payload:
+		default:
+			// client buffer full, skip
+		}
+	}
+}
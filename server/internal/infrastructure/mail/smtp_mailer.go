@@ -1,9 +1,13 @@
 package mail
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 	"os"
 	"strings"
 
@@ -21,7 +25,7 @@ type smtpMailer struct {
 
 // NewSMTPMailer returns a Mailer that sends via SMTP (e.g. Gmail SMTP).
 // Set env: SMTP_HOST, SMTP_PORT, SMTP_USER, SMTP_PASSWORD, SMTP_FROM, FRONTEND_URL.
-// If SMTP_HOST is empty, SendInviteEmail is a no-op (for local dev without email).
+// If SMTP_HOST is empty, sending is a no-op (for local dev without email).
 func NewSMTPMailer() services.Mailer {
 	host := os.Getenv("SMTP_HOST")
 	return &smtpMailer{
@@ -41,21 +45,126 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
-func (m *smtpMailer) SendInviteEmail(ctx context.Context, toEmail, eventName, rsvpURL string) error {
+func (m *smtpMailer) SendInviteEmail(ctx context.Context, toEmail, eventName, rsvpURL string, invitation *services.CalendarPart) error {
+	subject := fmt.Sprintf("You're invited to %s", eventName)
+	body := fmt.Sprintf("You have been invited to %s.\n\nRSVP here: %s\n", eventName, m.absoluteURL(rsvpURL))
+	return m.send(toEmail, subject, body, invitation)
+}
+
+func (m *smtpMailer) SendWaitlistPromotionEmail(ctx context.Context, toEmail, eventName, rsvpURL string) error {
+	subject := fmt.Sprintf("A spot opened up at %s", eventName)
+	body := fmt.Sprintf("Good news: a spot opened up at %s and your RSVP is now confirmed.\n\nChoose your seat here: %s\n", eventName, m.absoluteURL(rsvpURL))
+	return m.send(toEmail, subject, body, nil)
+}
+
+func (m *smtpMailer) SendEventUpdatedEmail(ctx context.Context, toEmail, eventName, eventURL string, update *services.CalendarPart) error {
+	subject := fmt.Sprintf("Updated: %s", eventName)
+	body := fmt.Sprintf("The date, time or place of %s has changed. The attached invitation updates your calendar.\n\nSee the details here: %s\n", eventName, m.absoluteURL(eventURL))
+	return m.send(toEmail, subject, body, update)
+}
+
+func (m *smtpMailer) SendEventCancelledEmail(ctx context.Context, toEmail, eventName string, cancellation *services.CalendarPart) error {
+	subject := fmt.Sprintf("Cancelled: %s", eventName)
+	body := fmt.Sprintf("%s has been cancelled by the organizer. The attached notice removes it from your calendar.\n", eventName)
+	return m.send(toEmail, subject, body, cancellation)
+}
+
+func (m *smtpMailer) SendRSVPDeclinedEmail(ctx context.Context, toEmail, eventName string, cancellation *services.CalendarPart) error {
+	subject := fmt.Sprintf("Declined: %s", eventName)
+	body := fmt.Sprintf("You have declined %s. The attached notice removes it from your calendar.\n", eventName)
+	return m.send(toEmail, subject, body, cancellation)
+}
+
+// absoluteURL prefixes a relative path with FRONTEND_URL.
+func (m *smtpMailer) absoluteURL(path string) string {
+	if path != "" && !strings.HasPrefix(path, "http") {
+		return strings.TrimSuffix(m.frontendURL, "/") + path
+	}
+	return path
+}
+
+func (m *smtpMailer) send(toEmail, subject, body string, cal *services.CalendarPart) error {
 	if m.host == "" || m.username == "" || m.password == "" {
 		return nil // no-op when not configured
 	}
-	// If rsvpURL is relative, make it absolute
-	if rsvpURL != "" && !strings.HasPrefix(rsvpURL, "http") {
-		rsvpURL = strings.TrimSuffix(m.frontendURL, "/") + rsvpURL
+	msg, err := buildMessage(toEmail, subject, body, cal)
+	if err != nil {
+		return err
 	}
-	subject := fmt.Sprintf("You're invited to %s", eventName)
-	body := fmt.Sprintf("You have been invited to %s.\n\nRSVP here: %s\n", eventName, rsvpURL)
-	msg := []byte("To: " + toEmail + "\r\n" +
-		"Subject: " + subject + "\r\n" +
-		"Content-Type: text/plain; charset=UTF-8\r\n" +
-		"\r\n" + body)
 	addr := m.host + ":" + m.port
 	auth := smtp.PlainAuth("", m.username, m.password, m.host)
 	return smtp.SendMail(addr, auth, m.from, []string{toEmail}, msg)
 }
+
+// buildMessage returns a plain-text email or, with a calendar part, a multipart/mixed one laid out the
+// way calendar clients expect: the text and the text/calendar part as alternatives, plus the same
+// calendar as an invite.ics attachment for clients that only look at attachments.
+func buildMessage(toEmail, subject, body string, cal *services.CalendarPart) ([]byte, error) {
+	var msg bytes.Buffer
+	msg.WriteString("To: " + toEmail + "\r\n" +
+		"Subject: " + subject + "\r\n" +
+		"MIME-Version: 1.0\r\n")
+	if cal == nil {
+		msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n" +
+			"\r\n" + body)
+		return msg.Bytes(), nil
+	}
+
+	var altBody bytes.Buffer
+	alt := multipart.NewWriter(&altBody)
+	calendarType := "text/calendar; charset=UTF-8; method=" + cal.Method
+	if err := writePart(alt, "text/plain; charset=UTF-8", "", []byte(body)); err != nil {
+		return nil, err
+	}
+	if err := writePart(alt, calendarType, "", cal.Data); err != nil {
+		return nil, err
+	}
+	if err := alt.Close(); err != nil {
+		return nil, err
+	}
+
+	var mixedBody bytes.Buffer
+	mixed := multipart.NewWriter(&mixedBody)
+	altPart, err := mixed.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"multipart/alternative; boundary=" + alt.Boundary()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := altPart.Write(altBody.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := writePart(mixed, "application/ics; name=invite.ics", `attachment; filename="invite.ics"`, cal.Data); err != nil {
+		return nil, err
+	}
+	if err := mixed.Close(); err != nil {
+		return nil, err
+	}
+	msg.WriteString("Content-Type: multipart/mixed; boundary=" + mixed.Boundary() + "\r\n\r\n")
+	msg.Write(mixedBody.Bytes())
+	return msg.Bytes(), nil
+}
+
+// writePart adds a base64-encoded part to w.
+func writePart(w *multipart.Writer, contentType, disposition string, data []byte) error {
+	header := textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"base64"},
+	}
+	if disposition != "" {
+		header.Set("Content-Disposition", disposition)
+	}
+	part, err := w.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		if _, err := part.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = part.Write([]byte(encoded + "\r\n"))
+	return err
+}
@@ -21,12 +21,12 @@ func (r *eventChatThreadRepositoryImpl) Create(ctx context.Context, t *entities.
 	if t.ID == "" {
 		t.ID = uuid.New().String()
 	}
-	return r.db.WithContext(ctx).Create(t).Error
+	return dbFromContext(ctx, r.db).Create(t).Error
 }
 
 func (r *eventChatThreadRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.EventChatThread, error) {
 	var row entities.EventChatThread
-	err := r.db.WithContext(ctx).First(&row, "id = ?", id).Error
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +35,7 @@ func (r *eventChatThreadRepositoryImpl) FindByID(ctx context.Context, id string)
 
 func (r *eventChatThreadRepositoryImpl) FindByEventAndGuest(ctx context.Context, eventID, guestID string) (*entities.EventChatThread, error) {
 	var row entities.EventChatThread
-	err := r.db.WithContext(ctx).Where("event_id = ? AND guest_id = ?", eventID, guestID).First(&row).Error
+	err := dbFromContext(ctx, r.db).Where("event_id = ? AND guest_id = ?", eventID, guestID).First(&row).Error
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func (r *eventChatThreadRepositoryImpl) FindByEventAndGuest(ctx context.Context,
 
 func (r *eventChatThreadRepositoryImpl) ListThreadsForEvent(ctx context.Context, eventID string, userID string) ([]*entities.EventChatThread, error) {
 	var list []*entities.EventChatThread
-	err := r.db.WithContext(ctx).Where("event_id = ? AND (owner_id = ? OR guest_id = ?)", eventID, userID, userID).
+	err := dbFromContext(ctx, r.db).Where("event_id = ? AND (owner_id = ? OR guest_id = ?)", eventID, userID, userID).
 		Order("created_at DESC").Find(&list).Error
 	return list, err
 }
@@ -61,12 +61,12 @@ func (r *eventChatMessageRepositoryImpl) Create(ctx context.Context, m *entities
 	if m.ID == "" {
 		m.ID = uuid.New().String()
 	}
-	return r.db.WithContext(ctx).Create(m).Error
+	return dbFromContext(ctx, r.db).Create(m).Error
 }
 
 func (r *eventChatMessageRepositoryImpl) ListByThreadID(ctx context.Context, threadID string, limit, offset int) ([]*entities.EventChatMessage, int64, error) {
 	var total int64
-	if err := r.db.WithContext(ctx).Model(&entities.EventChatMessage{}).Where("thread_id = ?", threadID).Count(&total).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Model(&entities.EventChatMessage{}).Where("thread_id = ?", threadID).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 	if limit <= 0 {
@@ -76,7 +76,7 @@ func (r *eventChatMessageRepositoryImpl) ListByThreadID(ctx context.Context, thr
 		offset = 0
 	}
 	var list []*entities.EventChatMessage
-	err := r.db.WithContext(ctx).Where("thread_id = ?", threadID).
+	err := dbFromContext(ctx, r.db).Where("thread_id = ?", threadID).
 		Order("created_at ASC").Limit(limit).Offset(offset).Find(&list).Error
 	return list, total, err
 }
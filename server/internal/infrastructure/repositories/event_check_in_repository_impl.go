@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type eventCheckInRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewEventCheckInRepository(db *gorm.DB) repositories.EventCheckInRepository {
+	return &eventCheckInRepositoryImpl{db: db}
+}
+
+func (r *eventCheckInRepositoryImpl) Create(ctx context.Context, c *entities.EventCheckIn) (bool, error) {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	// The unique indexes on invite_id and companion_id turn a second scan into a no-op.
+	res := dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(c)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (r *eventCheckInRepositoryImpl) ListByInviteID(ctx context.Context, inviteID string) ([]*entities.EventCheckIn, error) {
+	var list []*entities.EventCheckIn
+	err := dbFromContext(ctx, r.db).Where("invite_id = ?", inviteID).Order("checked_in_at ASC, id ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *eventCheckInRepositoryImpl) ListByEventID(ctx context.Context, eventID string) ([]*entities.EventCheckIn, error) {
+	var list []*entities.EventCheckIn
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("checked_in_at DESC, id DESC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *eventCheckInRepositoryImpl) Delete(ctx context.Context, c *entities.EventCheckIn) error {
+	return dbFromContext(ctx, r.db).Delete(c).Error
+}
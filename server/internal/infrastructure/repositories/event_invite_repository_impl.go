@@ -2,11 +2,13 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type eventInviteRepositoryImpl struct {
@@ -22,21 +24,41 @@ func (r *eventInviteRepositoryImpl) Create(ctx context.Context, invite *entities
 	if invite.ID == "" {
 		invite.ID = uuid.New().String()
 	}
-	return r.db.WithContext(ctx).Create(invite).Error
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(invite).Error; err != nil {
+			return err
+		}
+		return saveCompanions(tx, invite)
+	})
+}
+
+func (r *eventInviteRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.EventInvite, error) {
+	var invite entities.EventInvite
+	err := dbFromContext(ctx, r.db).First(&invite, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadCompanions(ctx, &invite); err != nil {
+		return nil, err
+	}
+	return &invite, nil
 }
 
 func (r *eventInviteRepositoryImpl) ListByEventID(ctx context.Context, eventID string) ([]*entities.EventInvite, error) {
 	var invites []*entities.EventInvite
-	err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("created_at DESC").Find(&invites).Error
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("created_at DESC").Find(&invites).Error
 	if err != nil {
 		return nil, err
 	}
+	if err := r.loadCompanions(ctx, invites...); err != nil {
+		return nil, err
+	}
 	return invites, nil
 }
 
 func (r *eventInviteRepositoryImpl) ListByEventIDPaginated(ctx context.Context, eventID string, limit, offset int) ([]*entities.EventInvite, int64, error) {
 	var total int64
-	if err := r.db.WithContext(ctx).Model(&entities.EventInvite{}).Where("event_id = ?", eventID).Count(&total).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Model(&entities.EventInvite{}).Where("event_id = ?", eventID).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 	if limit <= 0 {
@@ -46,32 +68,38 @@ func (r *eventInviteRepositoryImpl) ListByEventIDPaginated(ctx context.Context,
 		offset = 0
 	}
 	var invites []*entities.EventInvite
-	err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("created_at DESC").Limit(limit).Offset(offset).Find(&invites).Error
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("created_at DESC").Limit(limit).Offset(offset).Find(&invites).Error
 	if err != nil {
 		return nil, 0, err
 	}
+	if err := r.loadCompanions(ctx, invites...); err != nil {
+		return nil, 0, err
+	}
 	return invites, total, nil
 }
 
 func (r *eventInviteRepositoryImpl) ExistsByEventAndUser(ctx context.Context, eventID, userID string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&entities.EventInvite{}).
+	err := dbFromContext(ctx, r.db).Model(&entities.EventInvite{}).
 		Where("event_id = ? AND user_id = ?", eventID, userID).Count(&count).Error
 	return count > 0, err
 }
 
 func (r *eventInviteRepositoryImpl) ListByUserID(ctx context.Context, userID string) ([]*entities.EventInvite, error) {
 	var invites []*entities.EventInvite
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").Find(&invites).Error
+	err := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Order("created_at DESC").Find(&invites).Error
 	if err != nil {
 		return nil, err
 	}
+	if err := r.loadCompanions(ctx, invites...); err != nil {
+		return nil, err
+	}
 	return invites, nil
 }
 
 func (r *eventInviteRepositoryImpl) ListByUserIDPaginated(ctx context.Context, userID string, limit, offset int) ([]*entities.EventInvite, int64, error) {
 	var total int64
-	if err := r.db.WithContext(ctx).Model(&entities.EventInvite{}).Where("user_id = ?", userID).Count(&total).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Model(&entities.EventInvite{}).Where("user_id = ?", userID).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 	if limit <= 0 {
@@ -81,56 +109,73 @@ func (r *eventInviteRepositoryImpl) ListByUserIDPaginated(ctx context.Context, u
 		offset = 0
 	}
 	var invites []*entities.EventInvite
-	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at DESC").Limit(limit).Offset(offset).Find(&invites).Error
+	err := dbFromContext(ctx, r.db).Where("user_id = ?", userID).Order("created_at DESC").Limit(limit).Offset(offset).Find(&invites).Error
 	if err != nil {
 		return nil, 0, err
 	}
+	if err := r.loadCompanions(ctx, invites...); err != nil {
+		return nil, 0, err
+	}
 	return invites, total, nil
 }
 
 func (r *eventInviteRepositoryImpl) FindByEventAndUser(ctx context.Context, eventID, userID string) (*entities.EventInvite, error) {
 	var invite entities.EventInvite
-	err := r.db.WithContext(ctx).Where("event_id = ? AND user_id = ?", eventID, userID).First(&invite).Error
+	err := dbFromContext(ctx, r.db).Where("event_id = ? AND user_id = ?", eventID, userID).First(&invite).Error
 	if err != nil {
 		return nil, err
 	}
+	if err := r.loadCompanions(ctx, &invite); err != nil {
+		return nil, err
+	}
 	return &invite, nil
 }
 
 func (r *eventInviteRepositoryImpl) FindByEventAndEmail(ctx context.Context, eventID string, email string) (*entities.EventInvite, error) {
 	var invite entities.EventInvite
-	err := r.db.WithContext(ctx).Where("event_id = ? AND LOWER(email) = LOWER(?)", eventID, email).First(&invite).Error
+	err := dbFromContext(ctx, r.db).Where("event_id = ? AND LOWER(email) = LOWER(?)", eventID, email).First(&invite).Error
 	if err != nil {
 		return nil, err
 	}
+	if err := r.loadCompanions(ctx, &invite); err != nil {
+		return nil, err
+	}
 	return &invite, nil
 }
 
 func (r *eventInviteRepositoryImpl) Update(ctx context.Context, invite *entities.EventInvite) error {
-	return r.db.WithContext(ctx).Save(invite).Error
+	return dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(invite).Error; err != nil {
+			return err
+		}
+		return saveCompanions(tx, invite)
+	})
 }
 
 func (r *eventInviteRepositoryImpl) ExistsByEventAndEmail(ctx context.Context, eventID string, email string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&entities.EventInvite{}).
+	err := dbFromContext(ctx, r.db).Model(&entities.EventInvite{}).
 		Where("event_id = ? AND LOWER(email) = LOWER(?)", eventID, email).Count(&count).Error
 	return count > 0, err
 }
 
 func (r *eventInviteRepositoryImpl) ListByUserIDOrEmail(ctx context.Context, userID string, email string) ([]*entities.EventInvite, error) {
 	var invites []*entities.EventInvite
-	err := r.db.WithContext(ctx).Where("user_id = ? OR (user_id IS NULL AND LOWER(email) = LOWER(?))", userID, email).
+	err := dbFromContext(ctx, r.db).Where("user_id = ? OR (user_id IS NULL AND LOWER(email) = LOWER(?))", userID, email).
 		Order("created_at DESC").Find(&invites).Error
 	if err != nil {
 		return nil, err
 	}
+	if err := r.loadCompanions(ctx, invites...); err != nil {
+		return nil, err
+	}
 	return invites, nil
 }
 
 func (r *eventInviteRepositoryImpl) ListByUserIDOrEmailPaginated(ctx context.Context, userID string, email string, limit, offset int) ([]*entities.EventInvite, int64, error) {
 	where := "user_id = ? OR (user_id IS NULL AND LOWER(email) = LOWER(?))"
 	var total int64
-	if err := r.db.WithContext(ctx).Model(&entities.EventInvite{}).Where(where, userID, email).Count(&total).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Model(&entities.EventInvite{}).Where(where, userID, email).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 	if limit <= 0 {
@@ -140,10 +185,13 @@ func (r *eventInviteRepositoryImpl) ListByUserIDOrEmailPaginated(ctx context.Con
 		offset = 0
 	}
 	var invites []*entities.EventInvite
-	err := r.db.WithContext(ctx).Where(where, userID, email).Order("created_at DESC").Limit(limit).Offset(offset).Find(&invites).Error
+	err := dbFromContext(ctx, r.db).Where(where, userID, email).Order("created_at DESC").Limit(limit).Offset(offset).Find(&invites).Error
 	if err != nil {
 		return nil, 0, err
 	}
+	if err := r.loadCompanions(ctx, invites...); err != nil {
+		return nil, 0, err
+	}
 	return invites, total, nil
 }
 
@@ -152,28 +200,36 @@ func (r *eventInviteRepositoryImpl) ListRecentByOwnerID(ctx context.Context, own
 		limit = 20
 	}
 	var invites []*entities.EventInvite
-	err := r.db.WithContext(ctx).Table("event_invites").
+	err := dbFromContext(ctx, r.db).Table("event_invites").
 		Joins("INNER JOIN events ON events.id = event_invites.event_id AND events.owner_id = ?", ownerID).
 		Select("event_invites.*").
 		Order("event_invites.created_at DESC").
 		Limit(limit).
 		Find(&invites).Error
-	return invites, err
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadCompanions(ctx, invites...); err != nil {
+		return nil, err
+	}
+	return invites, nil
 }
 
-func (r *eventInviteRepositoryImpl) CountByOwnerIDGroupByStatus(ctx context.Context, ownerID string) (total int64, confirmed int64, declined int64, pending int64, err error) {
+func (r *eventInviteRepositoryImpl) CountByOwnerIDGroupByStatus(ctx context.Context, ownerID string) (total int64, confirmed int64, declined int64, pending int64, waitlisted int64, err error) {
 	type row struct {
 		Status string `gorm:"column:status"`
 		Count  int64  `gorm:"column:count"`
 	}
 	var rows []row
-	err = r.db.WithContext(ctx).Table("event_invites").
+	err = dbFromContext(ctx, r.db).Table("event_invites").
 		Joins("INNER JOIN events ON events.id = event_invites.event_id AND events.owner_id = ?", ownerID).
-		Select("event_invites.status AS status, COUNT(*) AS count").
+		// Each invite counts the guest and the companions they have named.
+		Select(`event_invites.status AS status,
+			SUM(1 + (SELECT COUNT(*) FROM event_invite_companions c WHERE c.invite_id = event_invites.id)) AS count`).
 		Group("event_invites.status").
 		Scan(&rows).Error
 	if err != nil {
-		return 0, 0, 0, 0, err
+		return 0, 0, 0, 0, 0, err
 	}
 	for _, rw := range rows {
 		total += rw.Count
@@ -182,9 +238,64 @@ func (r *eventInviteRepositoryImpl) CountByOwnerIDGroupByStatus(ctx context.Cont
 			confirmed += rw.Count
 		case "declined":
 			declined += rw.Count
+		case "waitlisted":
+			waitlisted += rw.Count
 		default:
 			pending += rw.Count
 		}
 	}
-	return total, confirmed, declined, pending, nil
+	return total, confirmed, declined, pending, waitlisted, nil
+}
+
+// loadCompanions attaches their companions, in display order, to the invites with a single query.
+func (r *eventInviteRepositoryImpl) loadCompanions(ctx context.Context, invites ...*entities.EventInvite) error {
+	if len(invites) == 0 {
+		return nil
+	}
+	ids := make([]string, len(invites))
+	for i, inv := range invites {
+		ids[i] = inv.ID
+	}
+	var companions []*entities.EventInviteCompanion
+	err := dbFromContext(ctx, r.db).Where("invite_id IN ?", ids).
+		Order("display_order ASC, created_at ASC").Find(&companions).Error
+	if err != nil {
+		return err
+	}
+	byInvite := make(map[string][]*entities.EventInviteCompanion, len(invites))
+	for _, c := range companions {
+		byInvite[c.InviteID] = append(byInvite[c.InviteID], c)
+	}
+	for _, inv := range invites {
+		inv.Companions = byInvite[inv.ID]
+	}
+	return nil
+}
+
+// saveCompanions makes the stored companions of the invite match invite.Companions. Companions keep
+// their IDs, so seat assignments pointing at them stay valid; removed ones are deleted.
+func saveCompanions(tx *gorm.DB, invite *entities.EventInvite) error {
+	now := time.Now()
+	keep := make([]string, 0, len(invite.Companions))
+	for i, c := range invite.Companions {
+		if c.ID == "" {
+			c.ID = uuid.New().String()
+			c.CreatedAt = now
+		}
+		c.InviteID = invite.ID
+		c.DisplayOrder = i
+		c.UpdatedAt = now
+		keep = append(keep, c.ID)
+	}
+	q := tx.Where("invite_id = ?", invite.ID)
+	if len(keep) > 0 {
+		q = q.Where("id NOT IN ?", keep)
+	}
+	if err := q.Delete(&entities.EventInviteCompanion{}).Error; err != nil {
+		return err
+	}
+	if len(invite.Companions) == 0 {
+		return nil
+	}
+	return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&invite.Companions).Error
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type eventRepositoryImpl struct {
@@ -23,12 +24,12 @@ func (r *eventRepositoryImpl) Create(ctx context.Context, event *entities.Event)
 	if event.ID == "" {
 		event.ID = uuid.New().String()
 	}
-	return r.db.WithContext(ctx).Create(event).Error
+	return dbFromContext(ctx, r.db).Create(event).Error
 }
 
 func (r *eventRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.Event, error) {
 	var event entities.Event
-	err := r.db.WithContext(ctx).First(&event, "id = ?", id).Error
+	err := dbFromContext(ctx, r.db).First(&event, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
@@ -40,7 +41,7 @@ func (r *eventRepositoryImpl) FindByID(ctx context.Context, id string) (*entitie
 
 func (r *eventRepositoryImpl) FindByOwnerID(ctx context.Context, ownerID string) ([]*entities.Event, error) {
 	var events []*entities.Event
-	err := r.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("event_date DESC, start_time DESC").Find(&events).Error
+	err := dbFromContext(ctx, r.db).Where("owner_id = ?", ownerID).Order("event_date DESC, start_time DESC").Find(&events).Error
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +50,7 @@ func (r *eventRepositoryImpl) FindByOwnerID(ctx context.Context, ownerID string)
 
 func (r *eventRepositoryImpl) FindByOwnerIDPaginated(ctx context.Context, ownerID string, limit, offset int) ([]*entities.Event, int64, error) {
 	var total int64
-	if err := r.db.WithContext(ctx).Model(&entities.Event{}).Where("owner_id = ?", ownerID).Count(&total).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Model(&entities.Event{}).Where("owner_id = ?", ownerID).Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 	if limit <= 0 {
@@ -59,7 +60,7 @@ func (r *eventRepositoryImpl) FindByOwnerIDPaginated(ctx context.Context, ownerI
 		offset = 0
 	}
 	var events []*entities.Event
-	err := r.db.WithContext(ctx).Where("owner_id = ?", ownerID).Order("event_date DESC, start_time DESC").Limit(limit).Offset(offset).Find(&events).Error
+	err := dbFromContext(ctx, r.db).Where("owner_id = ?", ownerID).Order("event_date DESC, start_time DESC").Limit(limit).Offset(offset).Find(&events).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -68,7 +69,7 @@ func (r *eventRepositoryImpl) FindByOwnerIDPaginated(ctx context.Context, ownerI
 
 func (r *eventRepositoryImpl) FindByUserID(ctx context.Context, userID string) ([]*entities.Event, error) {
 	var ids []string
-	err := r.db.WithContext(ctx).Model(&entities.EventInvite{}).Where("user_id = ?", userID).Distinct("event_id").Pluck("event_id", &ids).Error
+	err := dbFromContext(ctx, r.db).Model(&entities.EventInvite{}).Where("user_id = ?", userID).Distinct("event_id").Pluck("event_id", &ids).Error
 	if err != nil {
 		return nil, err
 	}
@@ -76,7 +77,7 @@ func (r *eventRepositoryImpl) FindByUserID(ctx context.Context, userID string) (
 		return []*entities.Event{}, nil
 	}
 	var events []*entities.Event
-	err = r.db.WithContext(ctx).Where("id IN ?", ids).Order("event_date DESC, start_time DESC").Find(&events).Error
+	err = dbFromContext(ctx, r.db).Where("id IN ?", ids).Order("event_date DESC, start_time DESC").Find(&events).Error
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +86,7 @@ func (r *eventRepositoryImpl) FindByUserID(ctx context.Context, userID string) (
 
 func (r *eventRepositoryImpl) FindByUserIDPaginated(ctx context.Context, userID string, limit, offset int) ([]*entities.Event, int64, error) {
 	var ids []string
-	err := r.db.WithContext(ctx).Model(&entities.EventInvite{}).Where("user_id = ?", userID).Distinct("event_id").Pluck("event_id", &ids).Error
+	err := dbFromContext(ctx, r.db).Model(&entities.EventInvite{}).Where("user_id = ?", userID).Distinct("event_id").Pluck("event_id", &ids).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -104,7 +105,7 @@ func (r *eventRepositoryImpl) FindByUserIDPaginated(ctx context.Context, userID
 	}
 	pageIDs := ids[offset:end]
 	var events []*entities.Event
-	err = r.db.WithContext(ctx).Where("id IN ?", pageIDs).Order("event_date DESC, start_time DESC").Find(&events).Error
+	err = dbFromContext(ctx, r.db).Where("id IN ?", pageIDs).Order("event_date DESC, start_time DESC").Find(&events).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -118,19 +119,19 @@ func (r *eventRepositoryImpl) FindByEmail(ctx context.Context, email string) (*e
 
 func (r *eventRepositoryImpl) ExistsByID(ctx context.Context, id string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&entities.Event{}).Where("id = ?", id).Count(&count).Error
+	err := dbFromContext(ctx, r.db).Model(&entities.Event{}).Where("id = ?", id).Count(&count).Error
 	return count > 0, err
 }
 
 func (r *eventRepositoryImpl) ExistsByOwnerID(ctx context.Context, ownerID string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&entities.Event{}).Where("owner_id = ?", ownerID).Count(&count).Error
+	err := dbFromContext(ctx, r.db).Model(&entities.Event{}).Where("owner_id = ?", ownerID).Count(&count).Error
 	return count > 0, err
 }
 
 func (r *eventRepositoryImpl) ExistsByUserID(ctx context.Context, userID string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&entities.EventInvite{}).Where("user_id = ?", userID).Count(&count).Error
+	err := dbFromContext(ctx, r.db).Model(&entities.EventInvite{}).Where("user_id = ?", userID).Count(&count).Error
 	return count > 0, err
 }
 
@@ -141,16 +142,26 @@ func (r *eventRepositoryImpl) ExistsByEmail(ctx context.Context, email string) (
 
 func (r *eventRepositoryImpl) ExistsByName(ctx context.Context, name string) (bool, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&entities.Event{}).Where("name = ?", name).Count(&count).Error
+	err := dbFromContext(ctx, r.db).Model(&entities.Event{}).Where("name = ?", name).Count(&count).Error
 	return count > 0, err
 }
 
 func (r *eventRepositoryImpl) Update(ctx context.Context, event *entities.Event) error {
-	return r.db.WithContext(ctx).Save(event).Error
+	return dbFromContext(ctx, r.db).Save(event).Error
+}
+
+func (r *eventRepositoryImpl) LockByID(ctx context.Context, id string) (*entities.Event, error) {
+	var event entities.Event
+	err := dbFromContext(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).Take(&event).Error
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
 }
 
 func (r *eventRepositoryImpl) Delete(ctx context.Context, event *entities.Event) error {
-	return r.db.WithContext(ctx).Delete(event).Error
+	return dbFromContext(ctx, r.db).Delete(event).Error
 }
 
 func (r *eventRepositoryImpl) ListPublic(ctx context.Context, search string, limit, offset int) ([]*entities.Event, error) {
@@ -160,7 +171,7 @@ func (r *eventRepositoryImpl) ListPublic(ctx context.Context, search string, lim
 	if offset < 0 {
 		offset = 0
 	}
-	q := r.db.WithContext(ctx).Where("visibility = ?", "public")
+	q := dbFromContext(ctx, r.db).Where("visibility = ?", "public")
 	if search != "" {
 		q = q.Where("name ILIKE ?", "%"+search+"%")
 	}
@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type eventRoomRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewEventRoomRepository(db *gorm.DB) repositories.EventRoomRepository {
+	return &eventRoomRepositoryImpl{db: db}
+}
+
+func (r *eventRoomRepositoryImpl) Create(ctx context.Context, room *entities.EventRoom) error {
+	if room.ID == "" {
+		room.ID = uuid.New().String()
+	}
+	return dbFromContext(ctx, r.db).Create(room).Error
+}
+
+func (r *eventRoomRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.EventRoom, error) {
+	var row entities.EventRoom
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (r *eventRoomRepositoryImpl) ListByEventID(ctx context.Context, eventID string) ([]*entities.EventRoom, error) {
+	var list []*entities.EventRoom
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("display_order ASC, created_at ASC, id ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *eventRoomRepositoryImpl) Update(ctx context.Context, room *entities.EventRoom) error {
+	return dbFromContext(ctx, r.db).Save(room).Error
+}
+
+func (r *eventRoomRepositoryImpl) Delete(ctx context.Context, room *entities.EventRoom) error {
+	return dbFromContext(ctx, r.db).Delete(room).Error
+}
@@ -3,11 +3,14 @@ package repositories
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
 	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type eventSeatRepositoryImpl struct {
@@ -22,7 +25,7 @@ func (r *eventSeatRepositoryImpl) Create(ctx context.Context, s *entities.EventS
 	if s.ID == "" {
 		s.ID = uuid.New().String()
 	}
-	return r.db.WithContext(ctx).Create(s).Error
+	return dbFromContext(ctx, r.db).Create(s).Error
 }
 
 func (r *eventSeatRepositoryImpl) CreateBulk(ctx context.Context, seats []*entities.EventSeat) error {
@@ -34,12 +37,12 @@ func (r *eventSeatRepositoryImpl) CreateBulk(ctx context.Context, seats []*entit
 			s.ID = uuid.New().String()
 		}
 	}
-	return r.db.WithContext(ctx).Create(&seats).Error
+	return dbFromContext(ctx, r.db).Create(&seats).Error
 }
 
 func (r *eventSeatRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.EventSeat, error) {
 	var row entities.EventSeat
-	err := r.db.WithContext(ctx).First(&row, "id = ?", id).Error
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
@@ -51,7 +54,7 @@ func (r *eventSeatRepositoryImpl) FindByID(ctx context.Context, id string) (*ent
 
 func (r *eventSeatRepositoryImpl) ListByEventTableID(ctx context.Context, tableID string) ([]*entities.EventSeat, error) {
 	var list []*entities.EventSeat
-	err := r.db.WithContext(ctx).Where("event_table_id = ?", tableID).Order("display_order ASC, id ASC").Find(&list).Error
+	err := dbFromContext(ctx, r.db).Where("event_table_id = ?", tableID).Order("display_order ASC, id ASC").Find(&list).Error
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +63,7 @@ func (r *eventSeatRepositoryImpl) ListByEventTableID(ctx context.Context, tableI
 
 func (r *eventSeatRepositoryImpl) ListByEventID(ctx context.Context, eventID string) ([]*entities.EventSeat, error) {
 	var list []*entities.EventSeat
-	err := r.db.WithContext(ctx).Table("event_seats").
+	err := dbFromContext(ctx, r.db).Table("event_seats").
 		Joins("INNER JOIN event_tables ON event_tables.id = event_seats.event_table_id").
 		Where("event_tables.event_id = ?", eventID).
 		Order("event_tables.display_order ASC, event_tables.id ASC, event_seats.display_order ASC, event_seats.id ASC").
@@ -73,13 +76,102 @@ func (r *eventSeatRepositoryImpl) ListByEventID(ctx context.Context, eventID str
 }
 
 func (r *eventSeatRepositoryImpl) Update(ctx context.Context, s *entities.EventSeat) error {
-	return r.db.WithContext(ctx).Save(s).Error
+	return dbFromContext(ctx, r.db).Save(s).Error
 }
 
 func (r *eventSeatRepositoryImpl) Delete(ctx context.Context, s *entities.EventSeat) error {
-	return r.db.WithContext(ctx).Delete(s).Error
+	return dbFromContext(ctx, r.db).Delete(s).Error
 }
 
 func (r *eventSeatRepositoryImpl) DeleteByTableID(ctx context.Context, eventTableID string) error {
-	return r.db.WithContext(ctx).Where("event_table_id = ?", eventTableID).Delete(&entities.EventSeat{}).Error
+	return dbFromContext(ctx, r.db).Where("event_table_id = ?", eventTableID).Delete(&entities.EventSeat{}).Error
+}
+
+func (r *eventSeatRepositoryImpl) ListAssignmentsByEventID(ctx context.Context, eventID string) ([]*entities.EventSeatAssignment, error) {
+	var list []*entities.EventSeatAssignment
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("created_at ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *eventSeatRepositoryImpl) ReplaceInviteAssignments(ctx context.Context, inviteID string, assignments []*entities.EventSeatAssignment) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Where("invite_id = ?", inviteID).Delete(&entities.EventSeatAssignment{}).Error; err != nil {
+		return err
+	}
+	if len(assignments) == 0 {
+		return nil
+	}
+	for _, a := range assignments {
+		if a.ID == "" {
+			a.ID = uuid.New().String()
+		}
+		a.InviteID = inviteID
+	}
+	if err := db.Create(&assignments).Error; err != nil {
+		if isUniqueViolation(err, "seat_id") {
+			return apperrors.ErrSeatTaken
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *eventSeatRepositoryImpl) LockByIDs(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	var rows []*entities.EventSeat
+	// Lock in id order so concurrent claims on overlapping seats cannot deadlock.
+	return dbFromContext(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id IN ?", ids).Order("id ASC").Find(&rows).Error
+}
+
+func (r *eventSeatRepositoryImpl) ListActiveHoldsByEventID(ctx context.Context, eventID string, now time.Time) ([]*entities.EventSeatHold, error) {
+	var list []*entities.EventSeatHold
+	err := dbFromContext(ctx, r.db).Where("event_id = ? AND expires_at > ?", eventID, now).Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *eventSeatRepositoryImpl) ReplaceUserHolds(ctx context.Context, eventID, userID string, holds []*entities.EventSeatHold, now time.Time) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&entities.EventSeatHold{}).Error; err != nil {
+		return err
+	}
+	if len(holds) == 0 {
+		return nil
+	}
+	seatIDs := make([]string, len(holds))
+	for i, h := range holds {
+		if h.ID == "" {
+			h.ID = uuid.New().String()
+		}
+		h.EventID = eventID
+		h.UserID = userID
+		seatIDs[i] = h.SeatID
+	}
+	if err := db.Where("seat_id IN ? AND expires_at <= ?", seatIDs, now).Delete(&entities.EventSeatHold{}).Error; err != nil {
+		return err
+	}
+	if err := db.Create(&holds).Error; err != nil {
+		if isUniqueViolation(err, "seat_id") {
+			return apperrors.ErrSeatHeld
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *eventSeatRepositoryImpl) DeleteUserHolds(ctx context.Context, eventID, userID string) error {
+	return dbFromContext(ctx, r.db).Where("event_id = ? AND user_id = ?", eventID, userID).Delete(&entities.EventSeatHold{}).Error
+}
+
+func (r *eventSeatRepositoryImpl) DeleteExpiredHolds(ctx context.Context, now time.Time) (int64, error) {
+	res := dbFromContext(ctx, r.db).Where("expires_at <= ?", now).Delete(&entities.EventSeatHold{})
+	return res.RowsAffected, res.Error
 }
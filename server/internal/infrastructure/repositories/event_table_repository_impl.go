@@ -22,12 +22,12 @@ func (r *eventTableRepositoryImpl) Create(ctx context.Context, t *entities.Event
 	if t.ID == "" {
 		t.ID = uuid.New().String()
 	}
-	return r.db.WithContext(ctx).Create(t).Error
+	return dbFromContext(ctx, r.db).Create(t).Error
 }
 
 func (r *eventTableRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.EventTable, error) {
 	var row entities.EventTable
-	err := r.db.WithContext(ctx).First(&row, "id = ?", id).Error
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, err
@@ -39,7 +39,7 @@ func (r *eventTableRepositoryImpl) FindByID(ctx context.Context, id string) (*en
 
 func (r *eventTableRepositoryImpl) ListByEventID(ctx context.Context, eventID string) ([]*entities.EventTable, error) {
 	var list []*entities.EventTable
-	err := r.db.WithContext(ctx).Where("event_id = ?", eventID).Order("display_order ASC, id ASC").Find(&list).Error
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("display_order ASC, id ASC").Find(&list).Error
 	if err != nil {
 		return nil, err
 	}
@@ -47,9 +47,9 @@ func (r *eventTableRepositoryImpl) ListByEventID(ctx context.Context, eventID st
 }
 
 func (r *eventTableRepositoryImpl) Update(ctx context.Context, t *entities.EventTable) error {
-	return r.db.WithContext(ctx).Save(t).Error
+	return dbFromContext(ctx, r.db).Save(t).Error
 }
 
 func (r *eventTableRepositoryImpl) Delete(ctx context.Context, t *entities.EventTable) error {
-	return r.db.WithContext(ctx).Delete(t).Error
+	return dbFromContext(ctx, r.db).Delete(t).Error
 }
@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type floorElementRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewFloorElementRepository(db *gorm.DB) repositories.FloorElementRepository {
+	return &floorElementRepositoryImpl{db: db}
+}
+
+func (r *floorElementRepositoryImpl) Create(ctx context.Context, e *entities.FloorElement) error {
+	if e.ID == "" {
+		e.ID = uuid.New().String()
+	}
+	return dbFromContext(ctx, r.db).Create(e).Error
+}
+
+func (r *floorElementRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.FloorElement, error) {
+	var row entities.FloorElement
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (r *floorElementRepositoryImpl) ListByEventID(ctx context.Context, eventID string) ([]*entities.FloorElement, error) {
+	var list []*entities.FloorElement
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("created_at ASC, id ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *floorElementRepositoryImpl) Update(ctx context.Context, e *entities.FloorElement) error {
+	return dbFromContext(ctx, r.db).Save(e).Error
+}
+
+func (r *floorElementRepositoryImpl) Delete(ctx context.Context, e *entities.FloorElement) error {
+	return dbFromContext(ctx, r.db).Delete(e).Error
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type rsvpQuestionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRSVPQuestionRepository(db *gorm.DB) repositories.RSVPQuestionRepository {
+	return &rsvpQuestionRepositoryImpl{db: db}
+}
+
+func (r *rsvpQuestionRepositoryImpl) Create(ctx context.Context, q *entities.RSVPQuestion) error {
+	if q.ID == "" {
+		q.ID = uuid.New().String()
+	}
+	return dbFromContext(ctx, r.db).Create(q).Error
+}
+
+func (r *rsvpQuestionRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.RSVPQuestion, error) {
+	var row entities.RSVPQuestion
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (r *rsvpQuestionRepositoryImpl) ListByEventID(ctx context.Context, eventID string) ([]*entities.RSVPQuestion, error) {
+	var list []*entities.RSVPQuestion
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("display_order ASC, created_at ASC, id ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *rsvpQuestionRepositoryImpl) Update(ctx context.Context, q *entities.RSVPQuestion) error {
+	return dbFromContext(ctx, r.db).Save(q).Error
+}
+
+func (r *rsvpQuestionRepositoryImpl) Delete(ctx context.Context, q *entities.RSVPQuestion) error {
+	return dbFromContext(ctx, r.db).Delete(q).Error
+}
+
+func (r *rsvpQuestionRepositoryImpl) CountAnswersByQuestionID(ctx context.Context, questionID string) (int64, error) {
+	var n int64
+	err := dbFromContext(ctx, r.db).Model(&entities.RSVPAnswer{}).Where("question_id = ?", questionID).Count(&n).Error
+	return n, err
+}
+
+func (r *rsvpQuestionRepositoryImpl) ListAnswersByInviteID(ctx context.Context, inviteID string) ([]*entities.RSVPAnswer, error) {
+	var list []*entities.RSVPAnswer
+	err := dbFromContext(ctx, r.db).Where("invite_id = ?", inviteID).Order("created_at ASC, id ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *rsvpQuestionRepositoryImpl) ListAnswersByEventID(ctx context.Context, eventID string) ([]*entities.RSVPAnswer, error) {
+	var list []*entities.RSVPAnswer
+	err := dbFromContext(ctx, r.db).
+		Joins("INNER JOIN rsvp_questions ON rsvp_questions.id = rsvp_answers.question_id").
+		Where("rsvp_questions.event_id = ?", eventID).
+		Order("rsvp_answers.created_at ASC, rsvp_answers.id ASC").
+		Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *rsvpQuestionRepositoryImpl) ReplaceInviteAnswers(ctx context.Context, inviteID string, answers []*entities.RSVPAnswer) error {
+	db := dbFromContext(ctx, r.db)
+	if err := db.Where("invite_id = ?", inviteID).Delete(&entities.RSVPAnswer{}).Error; err != nil {
+		return err
+	}
+	if len(answers) == 0 {
+		return nil
+	}
+	for _, a := range answers {
+		if a.ID == "" {
+			a.ID = uuid.New().String()
+		}
+		a.InviteID = inviteID
+	}
+	return db.Create(&answers).Error
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type seatingConstraintRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSeatingConstraintRepository(db *gorm.DB) repositories.SeatingConstraintRepository {
+	return &seatingConstraintRepositoryImpl{db: db}
+}
+
+func (r *seatingConstraintRepositoryImpl) Create(ctx context.Context, c *entities.SeatingConstraint) error {
+	if c.ID == "" {
+		c.ID = uuid.New().String()
+	}
+	if err := dbFromContext(ctx, r.db).Create(c).Error; err != nil {
+		if isUniqueViolation(err, "pair") {
+			return apperrors.ErrConstraintExists
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *seatingConstraintRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.SeatingConstraint, error) {
+	var row entities.SeatingConstraint
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (r *seatingConstraintRepositoryImpl) ListByEventID(ctx context.Context, eventID string) ([]*entities.SeatingConstraint, error) {
+	var list []*entities.SeatingConstraint
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("created_at ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *seatingConstraintRepositoryImpl) Update(ctx context.Context, c *entities.SeatingConstraint) error {
+	return dbFromContext(ctx, r.db).Save(c).Error
+}
+
+func (r *seatingConstraintRepositoryImpl) Delete(ctx context.Context, c *entities.SeatingConstraint) error {
+	return dbFromContext(ctx, r.db).Delete(c).Error
+}
@@ -0,0 +1,73 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type seatingSnapshotRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewSeatingSnapshotRepository(db *gorm.DB) repositories.SeatingSnapshotRepository {
+	return &seatingSnapshotRepositoryImpl{db: db}
+}
+
+func (r *seatingSnapshotRepositoryImpl) Create(ctx context.Context, s *entities.SeatingSnapshot) error {
+	if s.ID == "" {
+		s.ID = uuid.New().String()
+	}
+	return dbFromContext(ctx, r.db).Create(s).Error
+}
+
+func (r *seatingSnapshotRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.SeatingSnapshot, error) {
+	var row entities.SeatingSnapshot
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (r *seatingSnapshotRepositoryImpl) ListByEventID(ctx context.Context, eventID string, limit, offset int) ([]*entities.SeatingSnapshot, int64, error) {
+	var total int64
+	if err := dbFromContext(ctx, r.db).Model(&entities.SeatingSnapshot{}).Where("event_id = ?", eventID).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	var list []*entities.SeatingSnapshot
+	err := dbFromContext(ctx, r.db).Where("event_id = ?", eventID).Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&list).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
+func (r *seatingSnapshotRepositoryImpl) ExistsByEventID(ctx context.Context, eventID string) (bool, error) {
+	var count int64
+	err := dbFromContext(ctx, r.db).Model(&entities.SeatingSnapshot{}).Where("event_id = ?", eventID).Limit(1).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *seatingSnapshotRepositoryImpl) DeleteAllButLatest(ctx context.Context, eventID string, keep int, exceptReason string) error {
+	latest := dbFromContext(ctx, r.db).Model(&entities.SeatingSnapshot{}).Select("id").
+		Where("event_id = ? AND reason <> ?", eventID, exceptReason).Order("created_at DESC, id DESC").Limit(keep)
+	return dbFromContext(ctx, r.db).Where("event_id = ? AND reason <> ? AND id NOT IN (?)", eventID, exceptReason, latest).
+		Delete(&entities.SeatingSnapshot{}).Error
+}
+
+func (r *seatingSnapshotRepositoryImpl) DeleteAllButLatestWithReason(ctx context.Context, eventID, reason string, keep int) error {
+	latest := dbFromContext(ctx, r.db).Model(&entities.SeatingSnapshot{}).Select("id").
+		Where("event_id = ? AND reason = ?", eventID, reason).Order("created_at DESC, id DESC").Limit(keep)
+	return dbFromContext(ctx, r.db).Where("event_id = ? AND reason = ? AND id NOT IN (?)", eventID, reason, latest).
+		Delete(&entities.SeatingSnapshot{}).Error
+}
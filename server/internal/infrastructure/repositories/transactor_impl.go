@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+type txContextKey struct{}
+
+type transactorImpl struct {
+	db *gorm.DB
+}
+
+// NewTransactor returns a Transactor backed by GORM transactions.
+func NewTransactor(db *gorm.DB) repositories.Transactor {
+	return &transactorImpl{db: db}
+}
+
+func (t *transactorImpl) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested calls join the outer transaction.
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// dbFromContext returns the transaction stored in ctx by WithinTransaction, or db when there is none.
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// isUniqueViolation reports whether err is a PostgreSQL unique violation on a constraint whose name contains constraint.
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		return false
+	}
+	return constraint == "" || strings.Contains(pgErr.ConstraintName, constraint)
+}
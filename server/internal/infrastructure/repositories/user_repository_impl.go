@@ -22,7 +22,7 @@ func (r *userRepositoryImpl) Create(ctx context.Context, user *entities.User) er
 	if user.ID == "" {
 		user.ID = uuid.New().String()
 	}
-	result := r.db.WithContext(ctx).Create(user)
+	result := dbFromContext(ctx, r.db).Create(user)
 	if result.Error != nil {
 		return result.Error
 	}
@@ -31,7 +31,7 @@ func (r *userRepositoryImpl) Create(ctx context.Context, user *entities.User) er
 
 func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*entities.User, error) {
 	var user entities.User
-	result := r.db.WithContext(ctx).Where("email = ?", email).First(&user)
+	result := dbFromContext(ctx, r.db).Where("email = ?", email).First(&user)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, result.Error
@@ -43,7 +43,7 @@ func (r *userRepositoryImpl) FindByEmail(ctx context.Context, email string) (*en
 
 func (r *userRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.User, error) {
 	var user entities.User
-	result := r.db.WithContext(ctx).First(&user, "id = ?", id)
+	result := dbFromContext(ctx, r.db).First(&user, "id = ?", id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, result.Error
@@ -55,7 +55,7 @@ func (r *userRepositoryImpl) FindByID(ctx context.Context, id string) (*entities
 
 func (r *userRepositoryImpl) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	var count int64
-	result := r.db.WithContext(ctx).Model(&entities.User{}).Where("email = ?", email).Count(&count)
+	result := dbFromContext(ctx, r.db).Model(&entities.User{}).Where("email = ?", email).Count(&count)
 	if result.Error != nil {
 		return false, result.Error
 	}
@@ -63,7 +63,7 @@ func (r *userRepositoryImpl) ExistsByEmail(ctx context.Context, email string) (b
 }
 
 func (r *userRepositoryImpl) Update(ctx context.Context, user *entities.User) error {
-	result := r.db.WithContext(ctx).Model(user).Updates(map[string]interface{}{
+	result := dbFromContext(ctx, r.db).Model(user).Updates(map[string]interface{}{
 		"first_name":  user.FirstName,
 		"last_name":   user.LastName,
 		"phone":       user.Phone,
@@ -74,4 +74,17 @@ func (r *userRepositoryImpl) Update(ctx context.Context, user *entities.User) er
 		return result.Error
 	}
 	return nil
-}
\ No newline at end of file
+}
+func (r *userRepositoryImpl) FindByCalendarToken(ctx context.Context, token string) (*entities.User, error) {
+	var user entities.User
+	result := dbFromContext(ctx, r.db).Where("calendar_token = ?", token).First(&user)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &user, nil
+}
+
+func (r *userRepositoryImpl) SetCalendarToken(ctx context.Context, userID, token string) error {
+	return dbFromContext(ctx, r.db).Model(&entities.User{}).Where("id = ?", userID).
+		Update("calendar_token", token).Error
+}
@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/entities"
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/repositories"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type venueLayoutRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewVenueLayoutRepository(db *gorm.DB) repositories.VenueLayoutRepository {
+	return &venueLayoutRepositoryImpl{db: db}
+}
+
+func (r *venueLayoutRepositoryImpl) Create(ctx context.Context, l *entities.VenueLayout) error {
+	if l.ID == "" {
+		l.ID = uuid.New().String()
+	}
+	return dbFromContext(ctx, r.db).Create(l).Error
+}
+
+func (r *venueLayoutRepositoryImpl) FindByID(ctx context.Context, id string) (*entities.VenueLayout, error) {
+	var row entities.VenueLayout
+	err := dbFromContext(ctx, r.db).First(&row, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (r *venueLayoutRepositoryImpl) ListByOwnerID(ctx context.Context, ownerID string) ([]*entities.VenueLayout, error) {
+	var list []*entities.VenueLayout
+	err := dbFromContext(ctx, r.db).Where("owner_id = ?", ownerID).Order("name ASC, id ASC").Find(&list).Error
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (r *venueLayoutRepositoryImpl) Update(ctx context.Context, l *entities.VenueLayout) error {
+	return dbFromContext(ctx, r.db).Save(l).Error
+}
+
+func (r *venueLayoutRepositoryImpl) Delete(ctx context.Context, l *entities.VenueLayout) error {
+	return dbFromContext(ctx, r.db).Delete(l).Error
+}
@@ -0,0 +1,120 @@
+package security
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// TicketSigner signs ticket tokens with Ed25519 (JWS "EdDSA"), so scanners holding only the public key
+// can verify them offline.
+type TicketSigner struct {
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+	keyID      string
+}
+
+type ticketClaims struct {
+	EventID   string `json:"eid"`
+	InviteID  string `json:"iid"`
+	PartySize int    `json:"ps"`
+	jwt.RegisteredClaims
+}
+
+// NewTicketSigner loads the signing key from TICKET_SIGNING_KEY, a base64-encoded 32-byte Ed25519 seed
+// (e.g. `openssl rand -base64 32`). The key is required unless TICKET_SIGNING_KEY_EPHEMERAL=true, which
+// is meant for development: a throwaway key is generated, and tickets stop verifying when the server
+// restarts.
+func NewTicketSigner() (*TicketSigner, error) {
+	var seed []byte
+	if encoded := strings.TrimSpace(os.Getenv("TICKET_SIGNING_KEY")); encoded != "" {
+		var err error
+		seed, err = base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, fmt.Errorf("TICKET_SIGNING_KEY must be %d base64-encoded bytes", ed25519.SeedSize)
+		}
+	} else if os.Getenv("TICKET_SIGNING_KEY_EPHEMERAL") != "true" {
+		return nil, errors.New("TICKET_SIGNING_KEY environment variable is required (set TICKET_SIGNING_KEY_EPHEMERAL=true to use a temporary key in development)")
+	} else {
+		log.Println("TICKET_SIGNING_KEY not set, tickets are signed with a temporary key")
+		seed = make([]byte, ed25519.SeedSize)
+		if _, err := rand.Read(seed); err != nil {
+			return nil, err
+		}
+	}
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	sum := sha256.Sum256(publicKey)
+	return &TicketSigner{
+		privateKey: privateKey,
+		publicKey:  publicKey,
+		keyID:      hex.EncodeToString(sum[:8]),
+	}, nil
+}
+
+func (s *TicketSigner) Sign(c services.TicketClaims) (string, error) {
+	claims := &ticketClaims{
+		EventID:   c.EventID,
+		InviteID:  c.InviteID,
+		PartySize: c.PartySize,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(c.ExpiresAt),
+			IssuedAt:  jwt.NewNumericDate(c.IssuedAt),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = s.keyID
+	return token.SignedString(s.privateKey)
+}
+
+func (s *TicketSigner) Verify(tokenString string) (*services.TicketClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &ticketClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+			return nil, errors.New("invalid signing method")
+		}
+		return s.publicKey, nil
+	}, jwt.WithExpirationRequired())
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		return nil, apperrors.ErrTicketExpired
+	}
+	if err != nil {
+		return nil, apperrors.ErrInvalidTicket
+	}
+	claims, ok := token.Claims.(*ticketClaims)
+	if !ok || !token.Valid {
+		return nil, apperrors.ErrInvalidTicket
+	}
+	out := &services.TicketClaims{
+		EventID:   claims.EventID,
+		InviteID:  claims.InviteID,
+		PartySize: claims.PartySize,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+	if claims.IssuedAt != nil {
+		out.IssuedAt = claims.IssuedAt.Time
+	}
+	return out, nil
+}
+
+func (s *TicketSigner) PublicKey() services.TicketPublicKey {
+	der, _ := x509.MarshalPKIXPublicKey(s.publicKey)
+	return services.TicketPublicKey{
+		KeyID:     s.keyID,
+		Algorithm: jwt.SigningMethodEdDSA.Alg(),
+		PublicKey: s.publicKey,
+		PEM:       string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+}
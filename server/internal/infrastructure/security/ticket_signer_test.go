@@ -0,0 +1,126 @@
+package security
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+	apperrors "github.com/KalebAsratemedhin/seatmaster/pkg/errors"
+)
+
+func testSigner(t *testing.T, seedByte byte) *TicketSigner {
+	t.Helper()
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = seedByte
+	}
+	t.Setenv("TICKET_SIGNING_KEY", base64.StdEncoding.EncodeToString(seed))
+	s, err := NewTicketSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestTicketSignerRoundTrip(t *testing.T) {
+	s := testSigner(t, 1)
+	now := time.Now().UTC().Truncate(time.Second)
+	claims := services.TicketClaims{
+		EventID:   "event-1",
+		InviteID:  "invite-1",
+		PartySize: 3,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+	token, err := s.Sign(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Verify(token)
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+	if got.EventID != claims.EventID || got.InviteID != claims.InviteID || got.PartySize != claims.PartySize ||
+		!got.IssuedAt.Equal(claims.IssuedAt) || !got.ExpiresAt.Equal(claims.ExpiresAt) {
+		t.Errorf("Verify() = %+v, want %+v", got, claims)
+	}
+}
+
+func TestTicketSignerRejects(t *testing.T) {
+	s := testSigner(t, 1)
+	now := time.Now()
+	valid, err := s.Sign(services.TicketClaims{EventID: "event-1", InviteID: "invite-1", PartySize: 1, IssuedAt: now, ExpiresAt: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := s.Sign(services.TicketClaims{EventID: "event-1", InviteID: "invite-1", PartySize: 1, IssuedAt: now.Add(-2 * time.Hour), ExpiresAt: now.Add(-time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := testSigner(t, 2).Sign(services.TicketClaims{EventID: "event-1", InviteID: "invite-1", PartySize: 1, IssuedAt: now, ExpiresAt: now.Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(valid, ".")
+	// Raise the party size in the payload while keeping the signature.
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	raised := strings.Replace(string(payload), `"ps":1`, `"ps":9`, 1)
+	tampered := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(raised)) + "." + parts[2]
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"expired", expired, apperrors.ErrTicketExpired},
+		{"tampered payload", tampered, apperrors.ErrInvalidTicket},
+		{"signed with another key", other, apperrors.ErrInvalidTicket},
+		{"not a token", "not-a-token", apperrors.ErrInvalidTicket},
+		{"empty", "", apperrors.ErrInvalidTicket},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.Verify(tt.token); !errors.Is(err, tt.want) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTicketSignerKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		ephemeral string
+		wantErr   bool
+	}{
+		{name: "missing key", wantErr: true},
+		{name: "missing key in development", ephemeral: "true"},
+		{name: "not base64", key: "not base64!", wantErr: true},
+		{name: "wrong length", key: base64.StdEncoding.EncodeToString([]byte("short")), wantErr: true},
+		{name: "valid key", key: base64.StdEncoding.EncodeToString(make([]byte, 32))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TICKET_SIGNING_KEY", tt.key)
+			t.Setenv("TICKET_SIGNING_KEY_EPHEMERAL", tt.ephemeral)
+			_, err := NewTicketSigner()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTicketSigner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTicketSignerKeyIDIsStable(t *testing.T) {
+	a, b := testSigner(t, 7), testSigner(t, 7)
+	if a.PublicKey().KeyID != b.PublicKey().KeyID {
+		t.Errorf("key IDs differ for the same key: %q, %q", a.PublicKey().KeyID, b.PublicKey().KeyID)
+	}
+	if a.PublicKey().KeyID == testSigner(t, 8).PublicKey().KeyID {
+		t.Error("different keys share a key ID")
+	}
+}
@@ -0,0 +1,149 @@
+package tickets
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+	"github.com/go-pdf/fpdf"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// PDF layout in millimetres on an A4 page.
+const (
+	pdfMargin   = 10.0
+	pdfWidth    = 190.0 // A4 width less the margins
+	pdfBannerH  = 55.0
+	pdfQRSize   = 55.0
+	pdfStubW    = 65.0
+	pdfBodyFont = 11.0
+)
+
+// RenderPDF draws the ticket at the top of an A4 page, with selectable text.
+func (r *Renderer) RenderPDF(doc services.TicketDocument) ([]byte, error) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, pdfMargin)
+	pdf.SetTitle(doc.EventName+" – Ticket", true)
+	// Go fonts cover names and places beyond Latin-1, unlike the PDF core fonts.
+	pdf.AddUTF8FontFromBytes("go", "", goregular.TTF)
+	pdf.AddUTF8FontFromBytes("go", "B", gobold.TTF)
+	pdf.AddPage()
+
+	x, y := pdfMargin, pdfMargin
+	mainW := pdfWidth - pdfStubW
+	bodyH := 70 + float64(len(doc.Seats))*7
+	ticketH := pdfBannerH + bodyH
+
+	if banner := r.loadBanner(doc.BannerURL); banner != nil {
+		// 10 px per mm keeps the banner sharp in print.
+		if err := placeImage(pdf, "banner", coverCrop(banner, int(pdfWidth*10), int(pdfBannerH*10)), x, y, pdfWidth, pdfBannerH); err != nil {
+			return nil, err
+		}
+	} else {
+		setFill(pdf, colorPrimary)
+		pdf.Rect(x, y, pdfWidth, pdfBannerH, "F")
+	}
+
+	top := y + pdfBannerH
+	setFill(pdf, colorStub)
+	pdf.Rect(x+mainW, top, pdfStubW, bodyH, "F")
+	setDraw(pdf, colorBorder)
+	pdf.SetLineWidth(0.3)
+	pdf.Rect(x, y, pdfWidth, ticketH, "D")
+	pdf.SetDashPattern([]float64{2, 2}, 0)
+	pdf.Line(x+mainW, top, x+mainW, top+bodyH)
+	pdf.SetDashPattern(nil, 0)
+
+	pad := 8.0
+	textW := mainW - 2*pad
+	pdf.SetXY(x+pad, top+pad)
+	setText(pdf, colorText)
+	pdf.SetFont("go", "B", 20)
+	pdf.CellFormat(textW, 9, fitPDF(pdf, doc.EventName, textW), "", 2, "L", false, 0, "")
+	setText(pdf, colorMuted)
+	pdf.SetFont("go", "", pdfBodyFont)
+	pdf.CellFormat(textW, 6, doc.EventDate+"  ·  "+timeRange(doc), "", 2, "L", false, 0, "")
+	if doc.Location != "" {
+		pdf.CellFormat(textW, 6, fitPDF(pdf, doc.Location, textW), "", 2, "L", false, 0, "")
+	}
+	pdf.Ln(4)
+	pdf.SetFont("go", "", 8)
+	pdf.CellFormat(textW, 5, "GUEST", "", 2, "L", false, 0, "")
+	setText(pdf, colorPrimary)
+	pdf.SetFont("go", "B", 16)
+	pdf.CellFormat(textW, 8, fitPDF(pdf, doc.GuestName, textW), "", 2, "L", false, 0, "")
+	pdf.Ln(3)
+	setText(pdf, colorMuted)
+	pdf.SetFont("go", "", 8)
+	pdf.CellFormat(textW, 5, "SEATING", "", 2, "L", false, 0, "")
+	setText(pdf, colorText)
+	pdf.SetFont("go", "", pdfBodyFont)
+	for _, s := range doc.Seats {
+		pdf.SetX(x + pad)
+		pdf.CellFormat(textW/2, 7, fitPDF(pdf, s.Name, textW/2-2), "", 0, "L", false, 0, "")
+		pdf.CellFormat(textW/2, 7, fitPDF(pdf, seatLine(s), textW/2), "", 1, "L", false, 0, "")
+	}
+
+	qr, err := qrImage(doc.Token, 600)
+	if err != nil {
+		return nil, err
+	}
+	qrX := x + mainW + (pdfStubW-pdfQRSize)/2
+	qrY := top + (bodyH-pdfQRSize)/2
+	if err := placeImage(pdf, "qr", qr, qrX, qrY, pdfQRSize, pdfQRSize); err != nil {
+		return nil, err
+	}
+	setText(pdf, colorMuted)
+	pdf.SetFont("go", "", 8)
+	pdf.SetXY(x+mainW, qrY-7)
+	pdf.CellFormat(pdfStubW, 5, "TICKET", "", 0, "C", false, 0, "")
+	setText(pdf, colorText)
+	pdf.SetFont("go", "B", pdfBodyFont)
+	pdf.SetXY(x+mainW, qrY+pdfQRSize+2)
+	pdf.CellFormat(pdfStubW, 6, shortTicketID(doc.TicketID), "", 2, "C", false, 0, "")
+	setText(pdf, colorMuted)
+	pdf.SetFont("go", "", 8)
+	pdf.SetX(x + mainW)
+	pdf.CellFormat(pdfStubW, 5, fmt.Sprintf("ADMITS %d", len(doc.Seats)), "", 0, "C", false, 0, "")
+
+	pdf.SetXY(x, y+ticketH+4)
+	pdf.MultiCell(pdfWidth, 4.5, "Present this ticket, printed or on your phone, at the entrance. The QR code is signed and unique to your invitation.", "", "L", false)
+
+	var buf bytes.Buffer
+	if err := pdf.Output(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// placeImage embeds img as a PNG at the given position and size.
+func placeImage(pdf *fpdf.Fpdf, name string, img image.Image, x, y, w, h float64) error {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+	pdf.RegisterImageOptionsReader(name, fpdf.ImageOptions{ImageType: "PNG"}, &buf)
+	pdf.ImageOptions(name, x, y, w, h, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+	return pdf.Error()
+}
+
+// fitPDF cuts s short with an ellipsis when it is wider than w in the current font.
+func fitPDF(pdf *fpdf.Fpdf, s string, w float64) string {
+	if pdf.GetStringWidth(s) <= w {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && pdf.GetStringWidth(string(runes)+"…") > w {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
+
+func setFill(pdf *fpdf.Fpdf, c color.RGBA) { pdf.SetFillColor(int(c.R), int(c.G), int(c.B)) }
+func setDraw(pdf *fpdf.Fpdf, c color.RGBA) { pdf.SetDrawColor(int(c.R), int(c.G), int(c.B)) }
+func setText(pdf *fpdf.Fpdf, c color.RGBA) { pdf.SetTextColor(int(c.R), int(c.G), int(c.B)) }
@@ -0,0 +1,168 @@
+package tickets
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"sync"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// PNG layout in pixels: a main panel with the banner and details, and a stub with the QR code.
+const (
+	pngWidth     = 1200
+	pngMainWidth = 840
+	pngPadding   = 40
+	pngBannerH   = 180
+	pngQRSize    = 280
+	pngLineH     = 34
+)
+
+var (
+	fontsOnce sync.Once
+	fontsErr  error
+	regular   *opentype.Font
+	bold      *opentype.Font
+)
+
+func loadFonts() error {
+	fontsOnce.Do(func() {
+		if regular, fontsErr = opentype.Parse(goregular.TTF); fontsErr != nil {
+			return
+		}
+		bold, fontsErr = opentype.Parse(gobold.TTF)
+	})
+	return fontsErr
+}
+
+func newFace(f *opentype.Font, size float64) (font.Face, error) {
+	return opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+}
+
+// RenderPNG draws the ticket as a 1200px wide PNG image.
+func (r *Renderer) RenderPNG(doc services.TicketDocument) ([]byte, error) {
+	if err := loadFonts(); err != nil {
+		return nil, fmt.Errorf("load fonts: %w", err)
+	}
+	faces := map[string]float64{"title": 36, "name": 30, "body": 20, "label": 14}
+	face := make(map[string]font.Face, len(faces))
+	for key, size := range faces {
+		f := regular
+		if key == "title" || key == "name" {
+			f = bold
+		}
+		ff, err := newFace(f, size)
+		if err != nil {
+			return nil, err
+		}
+		defer ff.Close()
+		face[key] = ff
+	}
+
+	height := max(560, pngBannerH+pngPadding+230+len(doc.Seats)*pngLineH+pngPadding)
+	img := image.NewRGBA(image.Rect(0, 0, pngWidth, height))
+	fill(img, img.Bounds(), color.White)
+	fill(img, image.Rect(pngMainWidth, 0, pngWidth, height), colorStub)
+
+	// Banner, or a band in the brand colour when the event has none.
+	bannerRect := image.Rect(0, 0, pngMainWidth, pngBannerH)
+	if banner := r.loadBanner(doc.BannerURL); banner != nil {
+		draw.Draw(img, bannerRect, coverCrop(banner, bannerRect.Dx(), bannerRect.Dy()), image.Point{}, draw.Src)
+	} else {
+		fill(img, bannerRect, colorPrimary)
+	}
+
+	textW := pngMainWidth - 2*pngPadding
+	y := pngBannerH + pngPadding + 30
+	drawText(img, face["title"], colorText, pngPadding, y, textW, doc.EventName)
+	y += 40
+	drawText(img, face["body"], colorMuted, pngPadding, y, textW, doc.EventDate+"  ·  "+timeRange(doc))
+	if doc.Location != "" {
+		y += 30
+		drawText(img, face["body"], colorMuted, pngPadding, y, textW, doc.Location)
+	}
+	y += 50
+	drawText(img, face["label"], colorMuted, pngPadding, y, textW, "GUEST")
+	y += 34
+	drawText(img, face["name"], colorPrimary, pngPadding, y, textW, doc.GuestName)
+	y += 44
+	drawText(img, face["label"], colorMuted, pngPadding, y, textW, "SEATING")
+	for _, s := range doc.Seats {
+		y += pngLineH
+		drawText(img, face["body"], colorText, pngPadding, y, textW/2-10, s.Name)
+		drawText(img, face["body"], colorText, pngPadding+textW/2, y, textW/2, seatLine(s))
+	}
+
+	// Perforation between the main panel and the stub.
+	for dy := 0; dy < height; dy += 16 {
+		fill(img, image.Rect(pngMainWidth-1, dy, pngMainWidth+1, dy+8), colorBorder)
+	}
+
+	stubW := pngWidth - pngMainWidth
+	qr, err := qrImage(doc.Token, pngQRSize)
+	if err != nil {
+		return nil, err
+	}
+	qrX := pngMainWidth + (stubW-pngQRSize)/2
+	qrY := (height - pngQRSize) / 2
+	draw.Draw(img, image.Rect(qrX, qrY, qrX+pngQRSize, qrY+pngQRSize), qr, image.Point{}, draw.Src)
+	drawCentered(img, face["label"], colorMuted, pngMainWidth, stubW, qrY-24, "TICKET")
+	drawCentered(img, face["body"], colorText, pngMainWidth, stubW, qrY+pngQRSize+36, shortTicketID(doc.TicketID))
+	drawCentered(img, face["label"], colorMuted, pngMainWidth, stubW, qrY+pngQRSize+64, fmt.Sprintf("ADMITS %d", len(doc.Seats)))
+
+	// Outline
+	b := img.Bounds()
+	for _, edge := range []image.Rectangle{
+		image.Rect(0, 0, b.Dx(), 2), image.Rect(0, b.Dy()-2, b.Dx(), b.Dy()),
+		image.Rect(0, 0, 2, b.Dy()), image.Rect(b.Dx()-2, 0, b.Dx(), b.Dy()),
+	} {
+		fill(img, edge, colorBorder)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func fill(img *image.RGBA, rect image.Rectangle, c color.Color) {
+	draw.Draw(img, rect, image.NewUniform(c), image.Point{}, draw.Src)
+}
+
+// drawText writes s with its baseline at y, cut short with an ellipsis when wider than maxW.
+func drawText(img *image.RGBA, face font.Face, c color.Color, x, y, maxW int, s string) {
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(c), Face: face}
+	s = fitText(d, s, maxW)
+	d.Dot = fixed.P(x, y)
+	d.DrawString(s)
+}
+
+// drawCentered writes s centred in the column [x, x+w) with its baseline at y.
+func drawCentered(img *image.RGBA, face font.Face, c color.Color, x, w, y int, s string) {
+	d := &font.Drawer{Dst: img, Src: image.NewUniform(c), Face: face}
+	s = fitText(d, s, w-20)
+	d.Dot = fixed.P(x+(w-d.MeasureString(s).Round())/2, y)
+	d.DrawString(s)
+}
+
+func fitText(d *font.Drawer, s string, maxW int) string {
+	if d.MeasureString(s).Round() <= maxW {
+		return s
+	}
+	runes := []rune(strings.TrimSpace(s))
+	for len(runes) > 0 && d.MeasureString(string(runes)+"…").Round() > maxW {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "…"
+}
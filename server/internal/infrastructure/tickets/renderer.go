@@ -0,0 +1,120 @@
+package tickets
+
+import (
+	"image"
+	"image/color"
+	_ "image/gif"  // banner formats accepted by the upload handler
+	_ "image/jpeg" // banner formats accepted by the upload handler
+	_ "image/png"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/KalebAsratemedhin/seatmaster/internal/domain/services"
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // banner formats accepted by the upload handler
+)
+
+var (
+	colorPrimary = color.RGBA{105, 29, 0, 255}
+	colorText    = color.RGBA{15, 23, 42, 255}
+	colorMuted   = color.RGBA{100, 116, 139, 255}
+	colorBorder  = color.RGBA{226, 232, 240, 255}
+	colorStub    = color.RGBA{248, 250, 252, 255}
+)
+
+// Renderer draws tickets as PNG images and PDF documents in pure Go. Event banners are read from the
+// upload directory; banners hosted elsewhere are left out rather than fetched.
+type Renderer struct {
+	uploadDir string
+	baseURL   string
+}
+
+var _ services.TicketRenderer = (*Renderer)(nil)
+
+func NewRenderer(uploadDir, baseURL string) *Renderer {
+	return &Renderer{uploadDir: uploadDir, baseURL: strings.TrimSuffix(baseURL, "/")}
+}
+
+// loadBanner decodes the event banner when it is one of our uploads, and returns nil otherwise.
+func (r *Renderer) loadBanner(bannerURL string) image.Image {
+	if bannerURL == "" || r.uploadDir == "" {
+		return nil
+	}
+	u, err := url.Parse(bannerURL)
+	if err != nil {
+		return nil
+	}
+	if u.Host != "" {
+		base, err := url.Parse(r.baseURL)
+		if err != nil || base.Host != u.Host {
+			return nil
+		}
+	}
+	rel, ok := strings.CutPrefix(path.Clean(u.Path), "/uploads/")
+	if !ok {
+		return nil
+	}
+	f, err := os.Open(filepath.Join(r.uploadDir, filepath.FromSlash(rel)))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil
+	}
+	return img
+}
+
+// qrImage encodes the signed ticket token as a QR code of the given size in pixels.
+func qrImage(token string, size int) (image.Image, error) {
+	q, err := qrcode.New(token, qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	return q.Image(size), nil
+}
+
+// coverCrop scales img to fill a w×h box, cropping the overflow around the centre like CSS
+// object-fit: cover.
+func coverCrop(img image.Image, w, h int) *image.RGBA {
+	b := img.Bounds()
+	scale := max(float64(w)/float64(b.Dx()), float64(h)/float64(b.Dy()))
+	cw, ch := int(float64(w)/scale), int(float64(h)/scale)
+	x0 := b.Min.X + (b.Dx()-cw)/2
+	y0 := b.Min.Y + (b.Dy()-ch)/2
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, image.Rect(x0, y0, x0+cw, y0+ch), draw.Src, nil)
+	return dst
+}
+
+// seatLine describes where a party member sits.
+func seatLine(s services.TicketDocumentSeat) string {
+	switch {
+	case s.Table != "" && s.Seat != "":
+		return s.Table + " · Seat " + s.Seat
+	case s.Seat != "":
+		return "Seat " + s.Seat
+	}
+	return "No seat assigned"
+}
+
+// timeRange formats the event's start and end times.
+func timeRange(doc services.TicketDocument) string {
+	if doc.EndTime == "" {
+		return doc.StartTime
+	}
+	return doc.StartTime + " – " + doc.EndTime
+}
+
+// shortTicketID is the ticket number printed under the QR code.
+func shortTicketID(id string) string {
+	if len(id) > 8 {
+		id = id[:8]
+	}
+	return "#" + strings.ToUpper(id)
+}
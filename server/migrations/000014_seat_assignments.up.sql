@@ -0,0 +1,38 @@
+-- One row per occupied seat. The unique constraint on seat_id is what stops two invites
+-- from claiming the same seat; event_invites.seat_id / guest_seat_id mirror these rows.
+CREATE TABLE IF NOT EXISTS event_seat_assignments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    seat_id UUID NOT NULL REFERENCES event_seats(id) ON DELETE CASCADE,
+    invite_id UUID NOT NULL REFERENCES event_invites(id) ON DELETE CASCADE,
+    is_guest BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT event_seat_assignments_seat_id_key UNIQUE (seat_id),
+    CONSTRAINT event_seat_assignments_invite_id_is_guest_key UNIQUE (invite_id, is_guest)
+);
+CREATE INDEX IF NOT EXISTS idx_event_seat_assignments_event_id ON event_seat_assignments(event_id);
+
+-- Backfill from existing invites. When several invites point at the same seat, the one
+-- that was updated first keeps it (primary seats win over plus-one seats on ties).
+INSERT INTO event_seat_assignments (event_id, seat_id, invite_id, is_guest, created_at)
+SELECT DISTINCT ON (seat_id) event_id, seat_id, invite_id, is_guest, updated_at
+FROM (
+    SELECT event_id, seat_id, id AS invite_id, FALSE AS is_guest, updated_at
+    FROM event_invites WHERE seat_id IS NOT NULL
+    UNION ALL
+    SELECT event_id, guest_seat_id, id, TRUE, updated_at
+    FROM event_invites WHERE guest_seat_id IS NOT NULL
+) claims
+ORDER BY seat_id, updated_at ASC, is_guest ASC;
+
+-- Clear the seat pointers that lost the backfill so invites agree with the assignments.
+UPDATE event_invites SET seat_id = NULL
+WHERE seat_id IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM event_seat_assignments a
+    WHERE a.seat_id = event_invites.seat_id AND a.invite_id = event_invites.id AND a.is_guest = FALSE
+);
+UPDATE event_invites SET guest_seat_id = NULL
+WHERE guest_seat_id IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM event_seat_assignments a
+    WHERE a.seat_id = event_invites.guest_seat_id AND a.invite_id = event_invites.id AND a.is_guest = TRUE
+);
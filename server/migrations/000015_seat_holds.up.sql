@@ -0,0 +1,13 @@
+-- Short-lived seat holds taken while a guest is on the RSVP page. One hold per seat;
+-- expired rows are ignored by reads and swept by the server.
+CREATE TABLE IF NOT EXISTS event_seat_holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    seat_id UUID NOT NULL REFERENCES event_seats(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT event_seat_holds_seat_id_key UNIQUE (seat_id)
+);
+CREATE INDEX IF NOT EXISTS idx_event_seat_holds_event_id_user_id ON event_seat_holds(event_id, user_id);
+CREATE INDEX IF NOT EXISTS idx_event_seat_holds_expires_at ON event_seat_holds(expires_at);
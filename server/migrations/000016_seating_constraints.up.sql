@@ -0,0 +1,16 @@
+-- Organizer rules between two invites: seat them together, or never at the same table.
+CREATE TABLE IF NOT EXISTS seating_constraints (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('together', 'apart')),
+    first_invite_id UUID NOT NULL REFERENCES event_invites(id) ON DELETE CASCADE,
+    second_invite_id UUID NOT NULL REFERENCES event_invites(id) ON DELETE CASCADE,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CHECK (first_invite_id <> second_invite_id)
+);
+CREATE INDEX IF NOT EXISTS idx_seating_constraints_event_id ON seating_constraints(event_id);
+-- At most one rule per pair of guests, whichever order they were given in.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_seating_constraints_pair
+    ON seating_constraints(event_id, LEAST(first_invite_id, second_invite_id), GREATEST(first_invite_id, second_invite_id));
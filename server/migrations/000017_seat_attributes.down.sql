@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_event_seats_reserved_invite_id;
+ALTER TABLE event_seats
+    DROP COLUMN IF EXISTS reserved_invite_id,
+    DROP COLUMN IF EXISTS blocked,
+    DROP COLUMN IF EXISTS vip,
+    DROP COLUMN IF EXISTS accessible;
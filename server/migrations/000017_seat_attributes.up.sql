@@ -0,0 +1,7 @@
+ALTER TABLE event_seats
+    ADD COLUMN IF NOT EXISTS accessible BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS vip BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS blocked BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS reserved_invite_id UUID REFERENCES event_invites(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_seats_reserved_invite_id ON event_seats(reserved_invite_id);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS floor_elements;
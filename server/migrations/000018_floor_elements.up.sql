@@ -0,0 +1,16 @@
+-- Non-seating objects on the floor plan; position and size are percentages of the floor, like event_tables.
+CREATE TABLE IF NOT EXISTS floor_elements (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('stage', 'dance_floor', 'bar', 'entrance', 'buffet', 'restroom')),
+    label VARCHAR(255) NOT NULL DEFAULT '',
+    position_x REAL NOT NULL DEFAULT 0,
+    position_y REAL NOT NULL DEFAULT 0,
+    width REAL NOT NULL DEFAULT 10,
+    height REAL NOT NULL DEFAULT 10,
+    rotation REAL NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_floor_elements_event_id ON floor_elements(event_id);
@@ -0,0 +1,4 @@
+ALTER TABLE event_tables
+    DROP COLUMN IF EXISTS rotation,
+    DROP COLUMN IF EXISTS height,
+    DROP COLUMN IF EXISTS width;
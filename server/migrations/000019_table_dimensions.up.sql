@@ -0,0 +1,10 @@
+-- Table footprint on the floor plan, in the same 0-100 percentage space as position_x / position_y.
+ALTER TABLE event_tables
+    ADD COLUMN IF NOT EXISTS width REAL NOT NULL DEFAULT 10,
+    ADD COLUMN IF NOT EXISTS height REAL NOT NULL DEFAULT 10,
+    ADD COLUMN IF NOT EXISTS rotation REAL NOT NULL DEFAULT 0;
+
+UPDATE event_tables SET width = 16, height = 8 WHERE shape = 'rectangular';
+UPDATE event_tables
+SET width = LEAST(100, table_columns * 3 + 4), height = LEAST(100, table_rows * 3 + 6)
+WHERE shape = 'grid' AND table_rows IS NOT NULL AND table_columns IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE event_tables
+    DROP COLUMN IF EXISTS seat_labels,
+    DROP COLUMN IF EXISTS seat_label_scheme;
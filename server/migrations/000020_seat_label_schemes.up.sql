@@ -0,0 +1,5 @@
+-- How seats of a table are labeled: numeric (1, 2 ...), row_letter (A1, A2 ... B1) or custom (seat_labels).
+ALTER TABLE event_tables
+    ADD COLUMN IF NOT EXISTS seat_label_scheme VARCHAR(20) NOT NULL DEFAULT 'numeric'
+        CHECK (seat_label_scheme IN ('numeric', 'row_letter', 'custom')),
+    ADD COLUMN IF NOT EXISTS seat_labels JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,12 @@
+-- Reusable floor plans owned by a user; data holds the tables, seats and floor elements as JSON.
+CREATE TABLE IF NOT EXISTS venue_layouts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_venue_layouts_owner_id ON venue_layouts(owner_id);
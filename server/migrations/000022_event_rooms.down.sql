@@ -0,0 +1,3 @@
+ALTER TABLE floor_elements DROP COLUMN IF EXISTS room_id;
+ALTER TABLE event_tables DROP COLUMN IF EXISTS room_id;
+DROP TABLE IF EXISTS event_rooms;
@@ -0,0 +1,26 @@
+-- Rooms (or floors) of an event venue; tables and floor elements are placed inside a room.
+CREATE TABLE IF NOT EXISTS event_rooms (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    width REAL NOT NULL DEFAULT 30,
+    height REAL NOT NULL DEFAULT 20,
+    display_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_rooms_event_id ON event_rooms(event_id);
+
+-- Every existing event gets a default room holding its current tables and floor elements.
+INSERT INTO event_rooms (event_id, name) SELECT id, 'Main room' FROM events;
+
+ALTER TABLE event_tables ADD COLUMN IF NOT EXISTS room_id UUID REFERENCES event_rooms(id) ON DELETE CASCADE;
+UPDATE event_tables t SET room_id = r.id FROM event_rooms r WHERE r.event_id = t.event_id;
+ALTER TABLE event_tables ALTER COLUMN room_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_event_tables_room_id ON event_tables(room_id);
+
+ALTER TABLE floor_elements ADD COLUMN IF NOT EXISTS room_id UUID REFERENCES event_rooms(id) ON DELETE CASCADE;
+UPDATE floor_elements e SET room_id = r.id FROM event_rooms r WHERE r.event_id = e.event_id;
+ALTER TABLE floor_elements ALTER COLUMN room_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_floor_elements_room_id ON floor_elements(room_id);
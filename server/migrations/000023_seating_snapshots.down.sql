@@ -0,0 +1 @@
+DROP TABLE IF EXISTS seating_snapshots;
@@ -0,0 +1,12 @@
+-- Copies of an event's seating state (rooms, tables, seats, floor elements and seat assignments),
+-- one per change, for history, diff and restore.
+CREATE TABLE IF NOT EXISTS seating_snapshots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    reason VARCHAR(64) NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_seating_snapshots_event_id_created_at ON seating_snapshots(event_id, created_at DESC);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_event_invites_waitlist;
+UPDATE event_invites SET status = 'pending' WHERE status = 'waitlisted';
+ALTER TABLE event_invites DROP COLUMN IF EXISTS waitlisted_at;
+ALTER TABLE event_invites DROP COLUMN IF EXISTS plus_one;
+ALTER TABLE events DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,9 @@
+-- Optional attendee cap per event (plus-ones included) and a FIFO waitlist of invites confirmed past it.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS capacity INTEGER CHECK (capacity IS NULL OR capacity > 0);
+
+ALTER TABLE event_invites ADD COLUMN IF NOT EXISTS plus_one BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE event_invites ADD COLUMN IF NOT EXISTS waitlisted_at TIMESTAMP;
+
+UPDATE event_invites SET plus_one = TRUE WHERE guest_seat_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_event_invites_waitlist ON event_invites(event_id, waitlisted_at) WHERE status = 'waitlisted';
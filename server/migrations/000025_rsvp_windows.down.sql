@@ -0,0 +1,4 @@
+ALTER TABLE events DROP COLUMN IF EXISTS seating_locked;
+ALTER TABLE events DROP COLUMN IF EXISTS seat_selection_closes_at;
+ALTER TABLE events DROP COLUMN IF EXISTS seat_selection_opens_at;
+ALTER TABLE events DROP COLUMN IF EXISTS rsvp_deadline;
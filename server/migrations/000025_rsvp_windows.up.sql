@@ -0,0 +1,6 @@
+-- Organizer-controlled time rules for guests: an RSVP deadline, a seat-selection window and a flag that
+-- freezes guest seat changes while the chart is being finalised.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS rsvp_deadline TIMESTAMP;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS seat_selection_opens_at TIMESTAMP;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS seat_selection_closes_at TIMESTAMP;
+ALTER TABLE events ADD COLUMN IF NOT EXISTS seating_locked BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,33 @@
+ALTER TABLE event_invites ADD COLUMN IF NOT EXISTS guest_seat_id UUID REFERENCES event_seats(id) ON DELETE SET NULL;
+ALTER TABLE event_invites ADD COLUMN IF NOT EXISTS plus_one BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The first companion of each invite becomes its plus-one; further companions are dropped.
+WITH first_companion AS (
+    SELECT DISTINCT ON (invite_id) id, invite_id, seat_id
+    FROM event_invite_companions
+    ORDER BY invite_id, display_order, created_at
+)
+UPDATE event_invites i SET plus_one = TRUE, guest_seat_id = f.seat_id
+FROM first_companion f WHERE f.invite_id = i.id;
+
+ALTER TABLE event_seat_assignments ADD COLUMN IF NOT EXISTS is_guest BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE event_seat_assignments SET is_guest = TRUE WHERE companion_id IS NOT NULL;
+DELETE FROM event_seat_assignments a
+WHERE a.companion_id IS NOT NULL AND NOT EXISTS (
+    SELECT 1 FROM event_invites i WHERE i.id = a.invite_id AND i.guest_seat_id = a.seat_id
+);
+DROP INDEX IF EXISTS event_seat_assignments_companion_id_key;
+DROP INDEX IF EXISTS event_seat_assignments_invite_id_key;
+ALTER TABLE event_seat_assignments DROP COLUMN IF EXISTS companion_id;
+ALTER TABLE event_seat_assignments ADD CONSTRAINT event_seat_assignments_invite_id_is_guest_key UNIQUE (invite_id, is_guest);
+
+UPDATE seating_snapshots s SET data = jsonb_set(s.data, '{assignments}', (
+    SELECT COALESCE(jsonb_agg(
+        (e.a - 'companion_id') || jsonb_build_object('is_guest', COALESCE(e.a->>'companion_id', '') <> '')
+        ORDER BY e.ord), '[]'::jsonb)
+    FROM jsonb_array_elements(s.data->'assignments') WITH ORDINALITY AS e(a, ord)
+))
+WHERE jsonb_typeof(s.data->'assignments') = 'array';
+
+DROP TABLE IF EXISTS event_invite_companions;
+ALTER TABLE event_invites DROP COLUMN IF EXISTS party_size;
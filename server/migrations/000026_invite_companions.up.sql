@@ -0,0 +1,48 @@
+-- Invites get a party size set by the organizer and a list of named companions, each with an optional
+-- seat. Companions replace the single plus-one seat (guest_seat_id) and the plus_one flag.
+ALTER TABLE event_invites ADD COLUMN IF NOT EXISTS party_size INTEGER NOT NULL DEFAULT 2 CHECK (party_size BETWEEN 1 AND 20);
+
+CREATE TABLE IF NOT EXISTS event_invite_companions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    invite_id UUID NOT NULL REFERENCES event_invites(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    seat_id UUID REFERENCES event_seats(id) ON DELETE SET NULL,
+    display_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_event_invite_companions_invite_id ON event_invite_companions(invite_id);
+
+-- Every existing plus-one becomes a companion called "Guest" in the plus-one's seat.
+INSERT INTO event_invite_companions (invite_id, name, seat_id, created_at, updated_at)
+SELECT id, 'Guest', guest_seat_id, updated_at, updated_at
+FROM event_invites WHERE plus_one OR guest_seat_id IS NOT NULL;
+
+-- Seat assignments point at the companion instead of flagging the plus-one.
+ALTER TABLE event_seat_assignments ADD COLUMN IF NOT EXISTS companion_id UUID REFERENCES event_invite_companions(id) ON DELETE CASCADE;
+UPDATE event_seat_assignments a SET companion_id = c.id
+FROM event_invite_companions c
+WHERE a.is_guest AND c.invite_id = a.invite_id;
+DELETE FROM event_seat_assignments WHERE is_guest AND companion_id IS NULL;
+ALTER TABLE event_seat_assignments DROP CONSTRAINT IF EXISTS event_seat_assignments_invite_id_is_guest_key;
+ALTER TABLE event_seat_assignments DROP COLUMN IF EXISTS is_guest;
+CREATE UNIQUE INDEX IF NOT EXISTS event_seat_assignments_invite_id_key ON event_seat_assignments(invite_id) WHERE companion_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS event_seat_assignments_companion_id_key ON event_seat_assignments(companion_id);
+
+-- Rewrite plus-one assignments in seating snapshots the same way, dropping those whose invite no
+-- longer has a plus-one.
+UPDATE seating_snapshots s SET data = jsonb_set(s.data, '{assignments}', (
+    SELECT COALESCE(jsonb_agg(
+        CASE WHEN COALESCE((e.a->>'is_guest')::boolean, FALSE)
+            THEN (e.a - 'is_guest') || jsonb_build_object('companion_id', c.id)
+            ELSE e.a - 'is_guest'
+        END ORDER BY e.ord), '[]'::jsonb)
+    FROM jsonb_array_elements(s.data->'assignments') WITH ORDINALITY AS e(a, ord)
+    LEFT JOIN event_invite_companions c
+        ON COALESCE((e.a->>'is_guest')::boolean, FALSE) AND c.invite_id = (e.a->>'invite_id')::uuid
+    WHERE NOT COALESCE((e.a->>'is_guest')::boolean, FALSE) OR c.id IS NOT NULL
+))
+WHERE jsonb_typeof(s.data->'assignments') = 'array';
+
+ALTER TABLE event_invites DROP COLUMN IF EXISTS guest_seat_id;
+ALTER TABLE event_invites DROP COLUMN IF EXISTS plus_one;
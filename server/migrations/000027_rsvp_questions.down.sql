@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS rsvp_answers;
+DROP TABLE IF EXISTS rsvp_questions;
@@ -0,0 +1,30 @@
+-- Questions an organizer asks on the RSVP form (meal choice, dietary needs, song requests, ...).
+-- per_companion questions are answered by the guest and by each of their companions.
+CREATE TABLE IF NOT EXISTS rsvp_questions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    prompt VARCHAR(500) NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('text', 'single_choice', 'multi_choice')),
+    options JSONB NOT NULL DEFAULT '[]',
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    per_companion BOOLEAN NOT NULL DEFAULT FALSE,
+    display_order INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_rsvp_questions_event_id ON rsvp_questions(event_id);
+
+-- One answer per question and party member; companion_id is NULL for the guest's own answer.
+CREATE TABLE IF NOT EXISTS rsvp_answers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    question_id UUID NOT NULL REFERENCES rsvp_questions(id) ON DELETE CASCADE,
+    invite_id UUID NOT NULL REFERENCES event_invites(id) ON DELETE CASCADE,
+    companion_id UUID REFERENCES event_invite_companions(id) ON DELETE CASCADE,
+    text TEXT NOT NULL DEFAULT '',
+    choices JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_rsvp_answers_invite_id ON rsvp_answers(invite_id);
+CREATE UNIQUE INDEX IF NOT EXISTS rsvp_answers_guest_key ON rsvp_answers(question_id, invite_id) WHERE companion_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS rsvp_answers_companion_key ON rsvp_answers(question_id, companion_id) WHERE companion_id IS NOT NULL;
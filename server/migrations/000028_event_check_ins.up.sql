@@ -0,0 +1,13 @@
+-- Arrivals recorded by the organizer at the door, one row per party member; companion_id is NULL for
+-- the guest who holds the ticket.
+CREATE TABLE IF NOT EXISTS event_check_ins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    invite_id UUID NOT NULL REFERENCES event_invites(id) ON DELETE CASCADE,
+    companion_id UUID REFERENCES event_invite_companions(id) ON DELETE CASCADE,
+    checked_in_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    checked_in_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX IF NOT EXISTS idx_event_check_ins_event_id ON event_check_ins(event_id);
+CREATE UNIQUE INDEX IF NOT EXISTS event_check_ins_guest_key ON event_check_ins(invite_id) WHERE companion_id IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS event_check_ins_companion_key ON event_check_ins(companion_id) WHERE companion_id IS NOT NULL;
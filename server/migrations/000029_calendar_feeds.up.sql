@@ -0,0 +1,3 @@
+-- Secret token in each user's calendar subscription URL; NULL until they first ask for the feed.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS calendar_token VARCHAR(64);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_calendar_token ON users (calendar_token);
@@ -0,0 +1,3 @@
+-- iCalendar SEQUENCE of the event, raised whenever guests are sent an update or cancellation so their
+-- calendar apps apply it over the copy they already have.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS calendar_sequence INT NOT NULL DEFAULT 0;
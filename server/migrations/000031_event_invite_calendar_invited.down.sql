@@ -0,0 +1 @@
+ALTER TABLE event_invites DROP COLUMN IF EXISTS calendar_invited;
@@ -0,0 +1,5 @@
+-- Whether the guest was emailed a calendar invitation (iTIP REQUEST) for the event, so later updates
+-- and cancellations go to everyone holding a copy in their calendar. Invites made until now were all
+-- emailed when they were created.
+ALTER TABLE event_invites ADD COLUMN IF NOT EXISTS calendar_invited BOOLEAN NOT NULL DEFAULT FALSE;
+UPDATE event_invites SET calendar_invited = TRUE;
@@ -0,0 +1,3 @@
+-- IANA time zone of the event: event_date, start_time and end_time are wall-clock values in it.
+-- Existing events keep the UTC interpretation they were compared with until now.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
@@ -16,6 +16,7 @@ var (
 	ErrInvalidEventDate = errors.New("event date is required")
 	ErrInvalidStartTime = errors.New("start time is required")
 	ErrInvalidEndTime = errors.New("end time is required")
+	ErrInvalidTimezone = errors.New("timezone must be an IANA time zone name such as Africa/Addis_Ababa")
 	ErrInvalidOwnerID = errors.New("owner ID is required")
 	ErrInvalidLocation = errors.New("location is required")
 	ErrInvalidLatitude = errors.New("latitude is required")
@@ -24,4 +25,49 @@ var (
 	ErrInvalidEventID = errors.New("event ID is required")
 	ErrInvalidUserID = errors.New("user ID is required")
 	ErrInvalidInviteStatus = errors.New("invite status is required")
+
+	ErrSeatTaken = errors.New("seat is already taken")
+	ErrSeatHeld  = errors.New("seat is being held by another guest")
+
+	ErrSeatBlocked  = errors.New("seat is blocked")
+	ErrSeatReserved = errors.New("seat is reserved for another guest")
+
+	ErrSeatsAssigned = errors.New("seats being removed are assigned to guests")
+
+	ErrInvalidConstraintKind    = errors.New("kind must be together or apart")
+	ErrInvalidConstraintInvites = errors.New("a rule needs two different invites")
+	ErrConstraintExists         = errors.New("a rule for these guests already exists")
+
+	ErrInvalidFloorElementKind = errors.New("kind must be stage, dance_floor, bar, entrance, buffet or restroom")
+	ErrInvalidFloorPosition    = errors.New("position must be between 0 and 100")
+	ErrInvalidFloorElementSize = errors.New("width and height must be between 0 and 100")
+
+	ErrTableOverlap     = errors.New("table overlaps another table")
+	ErrTableOutOfBounds = errors.New("table must fit inside the floor")
+
+	ErrInvalidRoomSize = errors.New("room width and height must be greater than 0 and at most 1000")
+
+	ErrInvalidCapacity = errors.New("capacity must be at least 1")
+	ErrEventFull       = errors.New("event is at capacity")
+
+	ErrInvalidSeatSelectionWindow = errors.New("seat selection must open before it closes")
+	ErrRSVPClosed                 = errors.New("the RSVP deadline has passed")
+	ErrSeatSelectionNotOpen       = errors.New("seat selection has not opened yet")
+	ErrSeatSelectionClosed        = errors.New("seat selection has closed")
+	ErrSeatingLocked              = errors.New("seating is locked by the organizer")
+
+	ErrInvalidPartySize     = errors.New("party size must be between 1 and 20")
+	ErrPartyTooLarge        = errors.New("party is larger than the invite allows")
+	ErrInvalidCompanionName = errors.New("companion name is required")
+
+	ErrInvalidQuestionPrompt  = errors.New("question prompt is required")
+	ErrInvalidQuestionKind    = errors.New("kind must be text, single_choice or multi_choice")
+	ErrInvalidQuestionOptions = errors.New("choice questions need at least two distinct options; text questions take none")
+	ErrInvalidRSVPAnswer      = errors.New("answer does not match the question")
+	ErrRSVPAnswerRequired     = errors.New("a required question was not answered")
+
+	ErrInvalidTicket    = errors.New("ticket is not valid for this event")
+	ErrTicketExpired    = errors.New("ticket has expired")
+	ErrAlreadyCheckedIn = errors.New("already checked in")
+	ErrNotCheckedIn     = errors.New("not checked in")
 )
\ No newline at end of file
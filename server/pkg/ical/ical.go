@@ -0,0 +1,179 @@
+// Package ical writes iCalendar (RFC 5545) documents with VEVENT components.
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Calendar is a VCALENDAR object.
+type Calendar struct {
+	ProdID string
+	Name   string // X-WR-CALNAME, shown by calendar apps for subscribed feeds
+	Method string // iTIP method such as REQUEST or CANCEL; empty for plain downloads and feeds
+	// RefreshInterval asks subscribing clients to poll at least this often; zero leaves it to them.
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+// Event is a VEVENT component. Start and End are written as UTC times, which every client converts to
+// the viewer's time zone.
+type Event struct {
+	UID          string
+	Sequence     int
+	Stamp        time.Time
+	LastModified time.Time
+	Start        time.Time
+	End          time.Time
+	Summary      string
+	Description  string
+	Location     string
+	// Geo is the latitude and longitude of the location, when known.
+	Geo    *[2]float64
+	URL    string
+	Status string // TENTATIVE, CONFIRMED or CANCELLED
+	// Organizer and Attendees are required in iTIP messages and usually left out of plain calendars.
+	Organizer *Person
+	Attendees []Attendee
+}
+
+// Person is a calendar user addressed by email.
+type Person struct {
+	Name  string
+	Email string
+}
+
+// Attendee is an ATTENDEE of an event. PartStat is NEEDS-ACTION, ACCEPTED, DECLINED or TENTATIVE.
+type Attendee struct {
+	Person
+	PartStat string
+	RSVP     bool // whether the organizer asks for a reply by email
+}
+
+const (
+	utcLayout     = "20060102T150405Z"
+	maxLineOctets = 75
+)
+
+// Encode renders the calendar with CRLF line endings and long lines folded.
+func (c *Calendar) Encode() []byte {
+	w := &writer{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", c.ProdID)
+	w.line("CALSCALE", "GREGORIAN")
+	if c.Method != "" {
+		w.line("METHOD", c.Method)
+	}
+	if c.Name != "" {
+		w.line("X-WR-CALNAME", escape(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		d := duration(c.RefreshInterval)
+		w.line("REFRESH-INTERVAL;VALUE=DURATION", d)
+		w.line("X-PUBLISHED-TTL", d)
+	}
+	for _, e := range c.Events {
+		w.event(e)
+	}
+	w.line("END", "VCALENDAR")
+	return []byte(w.b.String())
+}
+
+type writer struct {
+	b strings.Builder
+}
+
+func (w *writer) event(e Event) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", e.UID)
+	w.line("SEQUENCE", fmt.Sprint(e.Sequence))
+	w.line("DTSTAMP", e.Stamp.UTC().Format(utcLayout))
+	if !e.LastModified.IsZero() {
+		w.line("LAST-MODIFIED", e.LastModified.UTC().Format(utcLayout))
+	}
+	w.line("DTSTART", e.Start.UTC().Format(utcLayout))
+	w.line("DTEND", e.End.UTC().Format(utcLayout))
+	w.line("SUMMARY", escape(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION", escape(e.Description))
+	}
+	if e.Location != "" {
+		w.line("LOCATION", escape(e.Location))
+	}
+	if e.Geo != nil {
+		w.line("GEO", fmt.Sprintf("%.6f;%.6f", e.Geo[0], e.Geo[1]))
+	}
+	if e.URL != "" {
+		w.line("URL", e.URL)
+	}
+	if e.Status != "" {
+		w.line("STATUS", e.Status)
+	}
+	if e.Organizer != nil {
+		w.line("ORGANIZER"+cn(e.Organizer.Name), "mailto:"+e.Organizer.Email)
+	}
+	for _, a := range e.Attendees {
+		params := cn(a.Name) + ";ROLE=REQ-PARTICIPANT"
+		if a.PartStat != "" {
+			params += ";PARTSTAT=" + a.PartStat
+		}
+		params += ";RSVP=" + strings.ToUpper(fmt.Sprint(a.RSVP))
+		w.line("ATTENDEE"+params, "mailto:"+a.Email)
+	}
+	w.line("END", "VEVENT")
+}
+
+// line writes "name:value", folding it into continuation lines of at most 75 octets without splitting
+// a UTF-8 sequence.
+func (w *writer) line(name, value string) {
+	s := name + ":" + value
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.b.WriteString(s[:cut])
+		w.b.WriteString("\r\n ")
+		s = s[cut:]
+		limit = maxLineOctets - 1 // continuation lines start with a space
+	}
+	w.b.WriteString(s)
+	w.b.WriteString("\r\n")
+}
+
+// cn returns the ";CN=" parameter for a display name, or "" when there is none.
+func cn(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ""
+	}
+	// Parameter values cannot contain DQUOTE, and need quoting when they contain ":", ";" or ",".
+	return `;CN="` + strings.ReplaceAll(name, `"`, "'") + `"`
+}
+
+// escape applies the TEXT value escaping of RFC 5545 section 3.3.11.
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// duration formats d as an RFC 5545 DURATION in whole minutes.
+func duration(d time.Duration) string {
+	m := int(d.Minutes())
+	switch {
+	case m%(24*60) == 0:
+		return fmt.Sprintf("P%dD", m/(24*60))
+	case m%60 == 0:
+		return fmt.Sprintf("PT%dH", m/60)
+	}
+	return fmt.Sprintf("PT%dM", m)
+}
@@ -0,0 +1,118 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain", "plain"},
+		{"a, b; c", `a\, b\; c`},
+		{`C:\path`, `C:\\path`},
+		{"line one\nline two", `line one\nline two`},
+		{"crlf\r\nand cr\r", `crlf\nand cr\n`},
+		{`\,`, `\\\,`},
+	}
+	for _, tt := range tests {
+		if got := escape(tt.in); got != tt.want {
+			t.Errorf("escape(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLineFolding(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"short", "Dinner"},
+		{"exactly one line", strings.Repeat("x", maxLineOctets-len("DESCRIPTION:"))},
+		{"one octet over", strings.Repeat("x", maxLineOctets-len("DESCRIPTION:")+1)},
+		{"several lines", strings.Repeat("abcdefghij", 30)},
+		{"multi-byte characters", strings.Repeat("é", 100)},
+		{"mixed widths", strings.Repeat("a€😀", 40)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &writer{}
+			w.line("DESCRIPTION", tt.value)
+			out := w.b.String()
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("output does not end with CRLF: %q", out)
+			}
+			lines := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			var unfolded strings.Builder
+			for i, l := range lines {
+				if len(l) > maxLineOctets {
+					t.Errorf("line %d is %d octets: %q", i, len(l), l)
+				}
+				if i > 0 {
+					if !strings.HasPrefix(l, " ") {
+						t.Fatalf("continuation line %d does not start with a space: %q", i, l)
+					}
+					l = l[1:]
+				}
+				if !utf8.ValidString(l) {
+					t.Errorf("line %d splits a UTF-8 sequence: %q", i, l)
+				}
+				unfolded.WriteString(l)
+			}
+			if want := "DESCRIPTION:" + tt.value; unfolded.String() != want {
+				t.Errorf("unfolded = %q, want %q", unfolded.String(), want)
+			}
+		})
+	}
+}
+
+func TestEncodeEscapesText(t *testing.T) {
+	start := time.Date(2026, 6, 1, 18, 0, 0, 0, time.UTC)
+	cal := &Calendar{
+		ProdID: "-//Test//EN",
+		Events: []Event{{
+			UID:         "1@test",
+			Stamp:       start,
+			Start:       start,
+			End:         start.Add(2 * time.Hour),
+			Summary:     "Dinner, drinks; dancing",
+			Description: "Dress code:\nformal",
+			Location:    "Hall A, Floor 2",
+		}},
+	}
+	out := string(cal.Encode())
+	for _, want := range []string{
+		"SUMMARY:Dinner\\, drinks\\; dancing\r\n",
+		"DESCRIPTION:Dress code:\\nformal\r\n",
+		"LOCATION:Hall A\\, Floor 2\r\n",
+		"DTSTART:20260601T180000Z\r\n",
+		"DTEND:20260601T200000Z\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Encode() is missing %q in:\n%s", want, out)
+		}
+	}
+	if !strings.HasPrefix(out, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(out, "END:VCALENDAR\r\n") {
+		t.Errorf("Encode() is not a VCALENDAR:\n%s", out)
+	}
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{time.Hour, "PT1H"},
+		{90 * time.Minute, "PT90M"},
+		{24 * time.Hour, "P1D"},
+		{48 * time.Hour, "P2D"},
+	}
+	for _, tt := range tests {
+		if got := duration(tt.in); got != tt.want {
+			t.Errorf("duration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}